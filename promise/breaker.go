@@ -0,0 +1,300 @@
+package promise
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/goexts/generic/configure"
+	"github.com/goexts/generic/res"
+)
+
+// ErrBreakerOpen is the error promises are rejected with when a Breaker
+// refuses a call.
+var ErrBreakerOpen = errors.New("promise: circuit breaker is open")
+
+// BreakerState is the state of a Breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets all calls through and records their outcome.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen fails every call fast with ErrBreakerOpen.
+	BreakerOpen
+	// BreakerHalfOpen lets a limited number of probe calls through to decide
+	// whether to close or to open again.
+	BreakerHalfOpen
+)
+
+// String returns the lower-case name of the state.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerOption configures a Breaker.
+type BreakerOption func(*breakerOptions)
+
+type breakerOptions struct {
+	window      time.Duration
+	buckets     int
+	threshold   float64
+	minRequests int
+	cooldown    time.Duration
+	probes      int
+	isFailure   func(error) bool
+	now         func() time.Time
+}
+
+// WithBreakerWindow sets the length of the rolling window over which the error
+// rate is computed, and the number of buckets it is divided into. The default
+// is ten seconds in ten buckets.
+func WithBreakerWindow(window time.Duration, buckets int) BreakerOption {
+	return func(o *breakerOptions) {
+		o.window = window
+		o.buckets = buckets
+	}
+}
+
+// WithFailureThreshold sets the error rate, between 0 and 1, at or above which
+// the breaker opens, and the minimum number of calls in the window before the
+// rate is considered. The default is 0.5 over at least 10 calls.
+func WithFailureThreshold(rate float64, minRequests int) BreakerOption {
+	return func(o *breakerOptions) {
+		o.threshold = rate
+		o.minRequests = minRequests
+	}
+}
+
+// WithBreakerCooldown sets how long the breaker stays open before it lets probe
+// calls through. The default is five seconds.
+func WithBreakerCooldown(d time.Duration) BreakerOption {
+	return func(o *breakerOptions) {
+		o.cooldown = d
+	}
+}
+
+// WithHalfOpenProbes sets how many probe calls are let through while half-open.
+// All of them must succeed for the breaker to close. The default is one.
+func WithHalfOpenProbes(n int) BreakerOption {
+	return func(o *breakerOptions) {
+		o.probes = n
+	}
+}
+
+// WithFailurePredicate sets the function deciding whether a rejection counts
+// as a failure. By default every rejection does; a predicate can exclude, for
+// example, context.Canceled or client errors.
+func WithFailurePredicate(isFailure func(error) bool) BreakerOption {
+	return func(o *breakerOptions) {
+		o.isFailure = isFailure
+	}
+}
+
+// WithBreakerClock sets the function the breaker uses to read the current
+// time. It allows the breaker to be tested with a fake clock. The default is
+// time.Now.
+func WithBreakerClock(now func() time.Time) BreakerOption {
+	return func(o *breakerOptions) {
+		o.now = now
+	}
+}
+
+// Breaker is a circuit breaker for asynchronous calls. Calls are made through
+// it with Protect. When the error rate over a rolling window reaches a
+// threshold, the breaker opens and fails calls fast with ErrBreakerOpen. After
+// a cooldown it lets probe calls through and closes again if they succeed.
+//
+// A Breaker is safe for concurrent use.
+type Breaker struct {
+	lock     sync.Mutex
+	opts     breakerOptions
+	state    BreakerState
+	gen      uint64 // Incremented on every state change
+	openedAt time.Time
+	inFlight int // Probes in flight while half-open
+	passed   int // Probes succeeded while half-open
+	window   []breakerBucket
+}
+
+type breakerBucket struct {
+	epoch     int64
+	successes int
+	failures  int
+}
+
+// NewBreaker creates a new Breaker in the closed state.
+func NewBreaker(opts ...BreakerOption) *Breaker {
+	b := &Breaker{
+		opts: breakerOptions{
+			window:      10 * time.Second,
+			buckets:     10,
+			threshold:   0.5,
+			minRequests: 10,
+			cooldown:    5 * time.Second,
+			probes:      1,
+			isFailure:   func(error) bool { return true },
+			now:         time.Now,
+		},
+	}
+	configure.Apply(&b.opts, opts)
+	if b.opts.buckets < 1 {
+		b.opts.buckets = 1
+	}
+	if b.opts.probes < 1 {
+		b.opts.probes = 1
+	}
+	b.window = make([]breakerBucket, b.opts.buckets)
+	return b
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() BreakerState {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.refresh(b.opts.now())
+	return b.state
+}
+
+// Protect calls fn through b. If the breaker refuses the call, fn is not called
+// and the returned promise is rejected with ErrBreakerOpen. Otherwise the
+// promise returned by fn is returned, and its outcome is recorded once it
+// settles. A panic in fn results in a rejected promise and counts as a failure.
+func Protect[T any](b *Breaker, fn func() *Promise[T]) *Promise[T] {
+	gen, ok := b.allow()
+	if !ok {
+		return FromResult(res.Err[T](ErrBreakerOpen))
+	}
+	p := runStep("Breaker", fn)
+	p.onSettle(func() {
+		b.record(gen, p.err)
+	})
+	return p
+}
+
+// refresh moves an open breaker to half-open once the cooldown has elapsed.
+// The caller must hold the lock.
+func (b *Breaker) refresh(now time.Time) {
+	if b.state == BreakerOpen && !now.Before(b.openedAt.Add(b.opts.cooldown)) {
+		b.setState(BreakerHalfOpen, now)
+	}
+}
+
+// allow decides whether a call may proceed. It returns the generation the call
+// belongs to, so that its outcome can be ignored if the state has changed in
+// the meantime.
+func (b *Breaker) allow() (uint64, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.refresh(b.opts.now())
+	switch b.state {
+	case BreakerClosed:
+		return b.gen, true
+	case BreakerHalfOpen:
+		if b.inFlight < b.opts.probes {
+			b.inFlight++
+			return b.gen, true
+		}
+	}
+	return 0, false
+}
+
+// record accounts for the outcome of a call admitted in generation gen.
+func (b *Breaker) record(gen uint64, err error) {
+	failed := err != nil && b.opts.isFailure(err)
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if gen != b.gen {
+		return
+	}
+	now := b.opts.now()
+	switch b.state {
+	case BreakerClosed:
+		bucket := b.bucket(now)
+		if failed {
+			bucket.failures++
+		} else {
+			bucket.successes++
+		}
+		if b.tripped(now) {
+			b.setState(BreakerOpen, now)
+		}
+	case BreakerHalfOpen:
+		if failed {
+			b.setState(BreakerOpen, now)
+			return
+		}
+		b.passed++
+		if b.passed >= b.opts.probes {
+			b.setState(BreakerClosed, now)
+		}
+	}
+}
+
+// setState switches to state and resets the bookkeeping of the previous one.
+// The caller must hold the lock.
+func (b *Breaker) setState(state BreakerState, now time.Time) {
+	b.state = state
+	b.gen++
+	b.inFlight = 0
+	b.passed = 0
+	if state == BreakerOpen {
+		b.openedAt = now
+	}
+	if state == BreakerClosed {
+		clear(b.window)
+	}
+}
+
+// epoch returns the index of the bucket width interval now falls into.
+func (b *Breaker) epoch(now time.Time) int64 {
+	width := int64(b.opts.window) / int64(len(b.window))
+	if width <= 0 {
+		width = 1
+	}
+	return now.UnixNano() / width
+}
+
+// bucket returns the bucket for now, resetting it if it holds stale counts.
+// The caller must hold the lock.
+func (b *Breaker) bucket(now time.Time) *breakerBucket {
+	epoch := b.epoch(now)
+	idx := int(epoch % int64(len(b.window)))
+	if idx < 0 {
+		idx += len(b.window)
+	}
+	bucket := &b.window[idx]
+	if bucket.epoch != epoch {
+		*bucket = breakerBucket{epoch: epoch}
+	}
+	return bucket
+}
+
+// tripped reports whether the error rate over the window has reached the
+// threshold. The caller must hold the lock.
+func (b *Breaker) tripped(now time.Time) bool {
+	oldest := b.epoch(now) - int64(len(b.window)) + 1
+	var successes, failures int
+	for _, bucket := range b.window {
+		if bucket.epoch >= oldest {
+			successes += bucket.successes
+			failures += bucket.failures
+		}
+	}
+	total := successes + failures
+	if total == 0 || total < b.opts.minRequests {
+		return false
+	}
+	return float64(failures)/float64(total) >= b.opts.threshold
+}
@@ -0,0 +1,165 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/goexts/generic/res"
+)
+
+// fakeClock is a manually advanced clock for deterministic breaker tests.
+type fakeClock struct {
+	lock sync.Mutex
+	t    time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.t = c.t.Add(d)
+}
+
+func TestBreaker(t *testing.T) {
+	errFailed := errors.New("failed")
+	succeed := func() *Promise[int] { return FromResult(res.Ok(1)) }
+	fail := func() *Promise[int] { return FromResult(res.Err[int](errFailed)) }
+
+	newBreaker := func(opts ...BreakerOption) (*Breaker, *fakeClock) {
+		clock := &fakeClock{t: time.Unix(1000, 0)}
+		opts = append([]BreakerOption{
+			WithBreakerWindow(10*time.Second, 10),
+			WithFailureThreshold(0.5, 4),
+			WithBreakerCooldown(5 * time.Second),
+			WithBreakerClock(clock.Now),
+		}, opts...)
+		return NewBreaker(opts...), clock
+	}
+
+	t.Run("Opens when the error rate reaches the threshold", func(t *testing.T) {
+		b, _ := newBreaker()
+
+		Protect(b, succeed)
+		Protect(b, succeed)
+		Protect(b, fail)
+		if b.State() != BreakerClosed {
+			t.Fatalf("Expected closed below the minimum number of calls, but got %v", b.State())
+		}
+		Protect(b, fail)
+		if b.State() != BreakerOpen {
+			t.Fatalf("Expected open at a 50%% error rate, but got %v", b.State())
+		}
+
+		called := false
+		_, err := Protect(b, func() *Promise[int] {
+			called = true
+			return succeed()
+		}).Await()
+		if !errors.Is(err, ErrBreakerOpen) || called {
+			t.Errorf("Expected ErrBreakerOpen without calling fn, but got %v (called=%v)", err, called)
+		}
+	})
+
+	t.Run("Old failures leave the window", func(t *testing.T) {
+		b, clock := newBreaker()
+
+		Protect(b, fail)
+		Protect(b, fail)
+		clock.Advance(11 * time.Second)
+		Protect(b, fail)
+		Protect(b, succeed)
+		Protect(b, succeed)
+		Protect(b, succeed)
+
+		if b.State() != BreakerClosed {
+			t.Errorf("Expected closed with a 25%% error rate in the window, but got %v", b.State())
+		}
+	})
+
+	t.Run("Half-open probing", func(t *testing.T) {
+		b, clock := newBreaker(WithHalfOpenProbes(2))
+		for i := 0; i < 4; i++ {
+			Protect(b, fail)
+		}
+
+		clock.Advance(5 * time.Second)
+		if b.State() != BreakerHalfOpen {
+			t.Fatalf("Expected half-open after the cooldown, but got %v", b.State())
+		}
+
+		d1, d2 := NewDeferred[int](), NewDeferred[int]()
+		p1 := Protect(b, d1.Promise)
+		p2 := Protect(b, d2.Promise)
+		if _, err := Protect(b, succeed).Await(); !errors.Is(err, ErrBreakerOpen) {
+			t.Errorf("Expected calls beyond the probes to be refused, but got %v", err)
+		}
+
+		d1.Resolve(1)
+		d2.Resolve(2)
+		p1.Await()
+		p2.Await()
+		if b.State() != BreakerClosed {
+			t.Errorf("Expected closed after successful probes, but got %v", b.State())
+		}
+	})
+
+	t.Run("Failed probe reopens", func(t *testing.T) {
+		b, clock := newBreaker()
+		for i := 0; i < 4; i++ {
+			Protect(b, fail)
+		}
+		clock.Advance(5 * time.Second)
+
+		Protect(b, fail)
+
+		if b.State() != BreakerOpen {
+			t.Errorf("Expected open after a failed probe, but got %v", b.State())
+		}
+	})
+
+	t.Run("Failure predicate and panics", func(t *testing.T) {
+		b, _ := newBreaker(WithFailurePredicate(func(err error) bool {
+			return !errors.Is(err, context.Canceled)
+		}))
+		for i := 0; i < 4; i++ {
+			Protect(b, func() *Promise[int] { return FromResult(res.Err[int](context.Canceled)) })
+		}
+		if b.State() != BreakerClosed {
+			t.Fatalf("Expected ignored errors not to open the breaker, but got %v", b.State())
+		}
+
+		var perr *PanicError
+		if _, err := Protect(b, func() *Promise[int] { panic("boom") }).Await(); !errors.As(err, &perr) {
+			t.Errorf("Expected a *PanicError, but got %v", err)
+		}
+	})
+
+	t.Run("Concurrent use", func(t *testing.T) {
+		b := NewBreaker()
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				Protect(b, func() *Promise[int] {
+					return Async(func() (int, error) {
+						if i%3 == 0 {
+							return 0, errFailed
+						}
+						return i, nil
+					})
+				}).Await()
+				_ = b.State()
+			}(i)
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,91 @@
+package promise
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/goexts/generic/res"
+)
+
+// ErrChanClosed is the error a promise created by FromChan is rejected with
+// when the channel is closed before a value is received.
+var ErrChanClosed = errors.New("promise: channel closed without a value")
+
+// FromChan creates a promise that resolves with the first value received from
+// ch. If ch is closed before any value is sent, the promise is rejected with
+// ErrChanClosed.
+func FromChan[T any](ch <-chan T) *Promise[T] {
+	return New(func(resolve func(T), reject func(error)) {
+		val, ok := <-ch
+		if !ok {
+			reject(ErrChanClosed)
+			return
+		}
+		resolve(val)
+	})
+}
+
+// Chan returns a channel that receives the outcome of the promise once it is
+// settled and is then closed. The channel is buffered, so the send never blocks
+// even if nobody receives from it.
+func (p *Promise[T]) Chan() <-chan res.Result[T] {
+	ch := make(chan res.Result[T], 1)
+	p.follow(func() {
+		ch <- res.Of(p.value, p.err)
+		close(ch)
+	})
+	return ch
+}
+
+// CompletedResults returns an iterator over the outcomes of ps in the order in
+// which the promises settle. Each step yields the index of the promise in ps
+// together with its outcome. Stopping the iteration early is safe.
+func CompletedResults[T any](ps ...*Promise[T]) iter.Seq2[int, res.Result[T]] {
+	return func(yield func(int, res.Result[T]) bool) {
+		for i := range completionOrder(ps) {
+			if !yield(i, ps[i].Result()) {
+				return
+			}
+		}
+	}
+}
+
+// Completed returns an iterator over the values of ps in the order in which the
+// promises are fulfilled. Each step yields the index of the promise in ps
+// together with its value. Rejected promises are skipped without being marked
+// as observed, so they are still reported by TrackUnhandled unless their
+// errors are consumed elsewhere; use CompletedResults to observe them.
+func Completed[T any](ps ...*Promise[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range completionOrder(ps) {
+			p := ps[i]
+			if p.err != nil {
+				continue
+			}
+			p.markHandled()
+			if !yield(i, p.value) {
+				return
+			}
+		}
+	}
+}
+
+// completionOrder returns an iterator over the indices of ps in the order in
+// which the promises settle. It does not mark their outcomes as observed.
+func completionOrder[T any](ps []*Promise[T]) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		// Buffered so that the callbacks never block, even if the consumer
+		// stops before all promises have settled.
+		order := make(chan int, len(ps))
+		for i, p := range ps {
+			p.onSettle(func() {
+				order <- i
+			})
+		}
+		for range ps {
+			if !yield(<-order) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package promise
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFromChan(t *testing.T) {
+	t.Run("Resolves with the first value", func(t *testing.T) {
+		ch := make(chan int, 2)
+		ch <- 1
+		ch <- 2
+
+		if val, err := FromChan(ch).Await(); err != nil || val != 1 {
+			t.Errorf("Expected (1, nil), but got (%v, %v)", val, err)
+		}
+	})
+
+	t.Run("Rejects when closed", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+
+		if _, err := FromChan(ch).Await(); !errors.Is(err, ErrChanClosed) {
+			t.Errorf("Expected ErrChanClosed, but got %v", err)
+		}
+	})
+}
+
+func TestPromise_Chan(t *testing.T) {
+	r, ok := <-Async(func() (string, error) { return "done", nil }).Chan()
+	if !ok || r.Unwrap() != "done" {
+		t.Errorf("Expected Ok(done), but got %v (ok=%v)", r, ok)
+	}
+}
+
+func TestCompleted(t *testing.T) {
+	delayed := func(d time.Duration, v int, err error) *Promise[int] {
+		return Async(func() (int, error) {
+			time.Sleep(d)
+			return v, err
+		})
+	}
+
+	t.Run("Yields in completion order", func(t *testing.T) {
+		ps := []*Promise[int]{
+			delayed(60*time.Millisecond, 0, nil),
+			delayed(0, 1, errors.New("failed")),
+			delayed(30*time.Millisecond, 2, nil),
+		}
+
+		var indices []int
+		for i, v := range Completed(ps...) {
+			if v != i {
+				t.Errorf("Expected value %d at index %d, but got %d", i, i, v)
+			}
+			indices = append(indices, i)
+		}
+
+		if len(indices) != 2 || indices[0] != 2 || indices[1] != 0 {
+			t.Errorf("Expected indices [2 0], but got %v", indices)
+		}
+	})
+
+	t.Run("Results include rejections", func(t *testing.T) {
+		var errs int
+		for _, r := range CompletedResults(delayed(0, 0, errors.New("failed")), delayed(0, 1, nil)) {
+			if r.IsErr() {
+				errs++
+			}
+		}
+		if errs != 1 {
+			t.Errorf("Expected 1 rejection, but got %d", errs)
+		}
+	})
+
+	t.Run("Skipped rejections stay unhandled", func(t *testing.T) {
+		stop := TrackUnhandled(nil)
+		defer stop()
+
+		failed := errors.New("skipped")
+		for range Completed(delayed(0, 0, failed), delayed(0, 1, nil)) {
+		}
+		if err := CheckUnhandled(); !errors.Is(err, failed) {
+			t.Errorf("Expected the skipped rejection to be reported, but got %v", err)
+		}
+	})
+
+	t.Run("Early stop", func(t *testing.T) {
+		for range CompletedResults(delayed(0, 0, nil), delayed(10*time.Millisecond, 1, nil)) {
+			break
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package promise
+
+// Deferred is a handle to a promise that is settled from the outside, for
+// instance by a callback of a network response router, rather than by an
+// executor function.
+//
+// Only the first call to Resolve or Reject settles the promise. Both report
+// whether they won, which helps to diagnose double-resolve bugs.
+type Deferred[T any] struct {
+	p *Promise[T]
+}
+
+// NewDeferred creates a new Deferred whose promise is pending.
+func NewDeferred[T any]() *Deferred[T] {
+	return &Deferred[T]{p: newPending[T](nil)}
+}
+
+// Promise returns the promise controlled by the Deferred.
+func (d *Deferred[T]) Promise() *Promise[T] {
+	return d.p
+}
+
+// Resolve fulfills the promise with value. It returns false if the promise had
+// already been settled, in which case the call has no effect.
+//
+// Callbacks chained on the promise are handed to their executor, so Resolve
+// does not wait for them unless InlineExecutor has been attached.
+func (d *Deferred[T]) Resolve(value T) bool {
+	return d.p.settle(value, nil, Fulfilled, false)
+}
+
+// Reject rejects the promise with err. It returns false if the promise had
+// already been settled, in which case the call has no effect.
+//
+// Callbacks chained on the promise are handed to their executor, so Reject
+// does not wait for them unless InlineExecutor has been attached.
+func (d *Deferred[T]) Reject(err error) bool {
+	var zero T
+	return d.p.settle(zero, err, Rejected, false)
+}
@@ -0,0 +1,68 @@
+package promise
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestDeferred(t *testing.T) {
+	t.Run("Resolve", func(t *testing.T) {
+		d := NewDeferred[string]()
+		p := d.Promise().Then(func(s string) string { return s + "!" })
+
+		if d.Promise().State() != Pending {
+			t.Fatalf("Expected a pending promise, but got %v", d.Promise().State())
+		}
+		if !d.Resolve("hello") {
+			t.Error("Expected the first Resolve to win")
+		}
+		if val, err := p.Await(); err != nil || val != "hello!" {
+			t.Errorf("Expected ('hello!', nil), but got (%v, %v)", val, err)
+		}
+	})
+
+	t.Run("Reject after Resolve loses", func(t *testing.T) {
+		d := NewDeferred[int]()
+
+		if !d.Resolve(1) {
+			t.Error("Expected Resolve to win")
+		}
+		if d.Reject(errors.New("late")) {
+			t.Error("Expected Reject after Resolve to lose")
+		}
+		if d.Resolve(2) {
+			t.Error("Expected a second Resolve to lose")
+		}
+		if val, err := d.Promise().Await(); err != nil || val != 1 {
+			t.Errorf("Expected (1, nil), but got (%v, %v)", val, err)
+		}
+	})
+
+	t.Run("Exactly one concurrent settlement wins", func(t *testing.T) {
+		d := NewDeferred[int]()
+		var wins atomic.Int32
+		var wg sync.WaitGroup
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				won := false
+				if i%2 == 0 {
+					won = d.Resolve(i)
+				} else {
+					won = d.Reject(errors.New("rejected"))
+				}
+				if won {
+					wins.Add(1)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		if n := wins.Load(); n != 1 {
+			t.Errorf("Expected exactly one winner, but got %d", n)
+		}
+	})
+}
@@ -62,5 +62,37 @@ Example of a complete chain:
 	fmt.Printf("Final result: %s\n", finalResult)
 
 This creates a readable, non-blocking sequence of dependent operations.
+
+# Callbacks and Executors
+
+Chaining does not park a goroutine per step. Callbacks passed to `Then`,
+`Catch`, `Finally` and the other combinators are registered on the source
+promise and, once it settles, each runs in a goroutine of its own, so they run
+concurrently and never on the stack of the code that settles the promise or
+attaches the callback. `WithExecutor` moves them elsewhere, for example onto a
+worker pool, or runs cheap callbacks inline with `InlineExecutor`:
+
+	p := fetch().WithExecutor(promise.InlineExecutor).Then(cheapTransform)
+
+# Inspecting State
+
+A promise can be inspected without blocking. `State` reports whether it is
+pending, fulfilled or rejected, `TryResult` returns the outcome only if it is
+already available, and `Done` exposes a channel for use in a select statement:
+
+	select {
+	case <-p.Done():
+		r, _ := p.TryResult()
+		handle(r)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+# Unhandled Rejections
+
+A rejected promise whose error is never observed silently drops that error.
+`TrackUnhandled` enables an opt-in tracker that reports such rejections when the
+promise is garbage-collected, and `CheckUnhandled` lists them on demand, which
+is convenient at the end of a test.
 */
 package promise
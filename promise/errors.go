@@ -0,0 +1,40 @@
+package promise
+
+import (
+	"fmt"
+
+	"github.com/goexts/generic/res"
+)
+
+// PanicError is the error a promise is rejected with when user code panics
+// while it is executed by the promise machinery. It embeds the *res.PanicError
+// holding the recovered value and the stack trace of the panicking goroutine,
+// and adds the stage that panicked, so that the failure can be diagnosed after
+// the fact. errors.As with a *res.PanicError also matches it.
+type PanicError struct {
+	*res.PanicError
+	// Stage is the name of the step that panicked, e.g. "executor" or "Then".
+	Stage string
+}
+
+// newPanicError creates a new PanicError for the given stage and recovered value.
+// It must be called from the deferred function that recovered the panic so that
+// the captured stack includes the panicking frames.
+func newPanicError(stage string, value any) *PanicError {
+	return &PanicError{
+		PanicError: res.NewPanicError(value),
+		Stage:      stage,
+	}
+}
+
+// Error implements the standard error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("promise %s panicked: %v", e.Stage, e.Value)
+}
+
+// Unwrap returns the embedded *res.PanicError, which in turn unwraps to the
+// panic value if it is itself an error, making PanicError compatible with
+// errors.Is and errors.As.
+func (e *PanicError) Unwrap() error {
+	return e.PanicError
+}
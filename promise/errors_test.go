@@ -0,0 +1,112 @@
+package promise
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestPanicError(t *testing.T) {
+	t.Run("Executor panic is typed", func(t *testing.T) {
+		p := New(func(_ func(int), _ func(error)) {
+			panic("boom")
+		})
+
+		_, err := p.Await()
+
+		var perr *PanicError
+		if !errors.As(err, &perr) {
+			t.Fatalf("Expected a *PanicError, but got %T: %v", err, err)
+		}
+		if perr.Stage != "executor" {
+			t.Errorf("Expected stage 'executor', but got %q", perr.Stage)
+		}
+		if perr.Value != "boom" {
+			t.Errorf("Expected panic value 'boom', but got %v", perr.Value)
+		}
+		if !strings.Contains(string(perr.Stack), "errors_test.go") {
+			t.Errorf("Expected stack trace to include the panicking frame, got:\n%s", perr.Stack)
+		}
+		var rerr *res.PanicError
+		if !errors.As(err, &rerr) || rerr != perr.PanicError {
+			t.Errorf("Expected the error to match *res.PanicError, but got %v", err)
+		}
+	})
+
+	t.Run("Panic value error is wrapped", func(t *testing.T) {
+		sentinel := errors.New("sentinel")
+		p := Async(func() (int, error) {
+			return 1, nil
+		}).Then(func(int) int {
+			panic(sentinel)
+		})
+
+		_, err := p.Await()
+
+		if !errors.Is(err, sentinel) {
+			t.Errorf("Expected error to wrap the panic value, but got %v", err)
+		}
+		var perr *PanicError
+		if !errors.As(err, &perr) || perr.Stage != "Then" {
+			t.Errorf("Expected a *PanicError from stage 'Then', but got %v", err)
+		}
+	})
+
+	t.Run("Stage names", func(t *testing.T) {
+		base := errors.New("base")
+		cases := map[string]*Promise[int]{
+			"Catch": Async(func() (int, error) { return 0, base }).Catch(func(error) (int, error) {
+				panic("catch")
+			}),
+			"ThenWithPromise": Async(func() (int, error) { return 0, nil }).ThenWithPromise(func(int) *Promise[int] {
+				panic("then")
+			}),
+			"Finally": Async(func() (int, error) { return 0, base }).Finally(func() {
+				panic("finally")
+			}),
+		}
+		for stage, p := range cases {
+			_, err := p.Await()
+			var perr *PanicError
+			if !errors.As(err, &perr) || perr.Stage != stage {
+				t.Errorf("Expected a *PanicError from stage %q, but got %v", stage, err)
+			}
+		}
+	})
+
+	t.Run("Downstream panics are not swallowed", func(t *testing.T) {
+		panicking := ExecutorFunc(func(func()) { panic("executor") })
+		src := newPending[int](InlineExecutor)
+		mid := src.Then(func(v int) int { return v + 1 })
+		mid.WithExecutor(panicking).Then(func(v int) int { return v })
+
+		func() {
+			defer func() {
+				if r := recover(); r != "executor" {
+					t.Errorf("Expected the executor panic to reach the settling goroutine, but got %v", r)
+				}
+			}()
+			src.resolve(1)
+		}()
+		if r, ok := mid.TryResult(); !ok || r.Unwrap() != 2 {
+			t.Errorf("Expected the Then stage to be fulfilled with 2, but got %v (ok=%v)", r, ok)
+		}
+	})
+
+	t.Run("Finally keeps the original error", func(t *testing.T) {
+		base := errors.New("base")
+		p := Async(func() (int, error) {
+			return 0, base
+		}).Finally(func() {
+			panic("cleanup")
+		})
+
+		_, err := p.Await()
+
+		if !errors.Is(err, base) {
+			t.Errorf("Expected the original error to be preserved, but got %v", err)
+		}
+	})
+}
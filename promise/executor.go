@@ -0,0 +1,34 @@
+package promise
+
+// Executor runs the callbacks registered on a promise once it settles. By
+// default each callback runs in a goroutine of its own; attaching an Executor
+// with WithExecutor moves them elsewhere, e.g. onto a worker pool or a
+// dedicated event-loop goroutine, or runs them inline with InlineExecutor.
+type Executor interface {
+	// Execute runs task. It may run it synchronously or hand it off.
+	Execute(task func())
+}
+
+// ExecutorFunc adapts an ordinary function to the Executor interface.
+type ExecutorFunc func(task func())
+
+// Execute calls f(task).
+func (f ExecutorFunc) Execute(task func()) {
+	f(task)
+}
+
+// GoExecutor runs every task in a new goroutine, like promises do by default.
+// It is useful to restore the default for a chain that inherited another
+// executor.
+var GoExecutor Executor = ExecutorFunc(func(task func()) {
+	go task()
+})
+
+// InlineExecutor runs every task synchronously: on the goroutine that settles
+// the promise, or within the call that registers the callback if the promise
+// is already settled. It saves a goroutine per step for cheap callbacks, but
+// callbacks then run one after another and must neither block nor take a lock
+// that the settling or registering goroutine may hold.
+var InlineExecutor Executor = ExecutorFunc(func(task func()) {
+	task()
+})
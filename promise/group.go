@@ -0,0 +1,106 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/goexts/generic/configure"
+)
+
+// GroupOption configures a Group.
+type GroupOption func(*groupOptions)
+
+type groupOptions struct {
+	keepGoing  bool
+	collectAll bool
+}
+
+// WithoutCancelOnError keeps the group's context alive when a child fails, so
+// that its siblings run to completion. By default the first failure cancels
+// the context.
+func WithoutCancelOnError() GroupOption {
+	return func(o *groupOptions) {
+		o.keepGoing = true
+	}
+}
+
+// WithCollectAllErrors makes Wait return all child errors joined with
+// errors.Join. By default Wait returns only the first error.
+func WithCollectAllErrors() GroupOption {
+	return func(o *groupOptions) {
+		o.collectAll = true
+	}
+}
+
+// Group is a collection of child promises working on subtasks of a common
+// task. It mirrors errgroup.Group, but every child is launched with Go and is
+// returned as a typed *Promise, so its individual result stays available.
+//
+// A Group must be created with NewGroup and must not be reused after Wait has
+// returned.
+type Group struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	opts   groupOptions
+	wg     sync.WaitGroup
+	lock   sync.Mutex
+	errs   []error
+}
+
+// NewGroup creates a new Group and a derived context that is canceled when a
+// child fails, unless WithoutCancelOnError is set, or when Wait returns. The
+// cause of the cancellation, available through context.Cause, is the first
+// child error.
+func NewGroup(ctx context.Context, opts ...GroupOption) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	g := &Group{ctx: ctx, cancel: cancel}
+	configure.Apply(&g.opts, opts)
+	return g, ctx
+}
+
+// Go launches fn as a child of g and returns its promise. fn receives the
+// group's context and should return early once it is canceled. A panic in fn
+// rejects the child's promise with a *PanicError and counts as a failure of
+// the group.
+func Go[T any](g *Group, fn func(ctx context.Context) (T, error)) *Promise[T] {
+	g.wg.Add(1)
+	p := Async(func() (T, error) {
+		return fn(g.ctx)
+	})
+	p.subscribe(func() {
+		defer g.wg.Done()
+		if p.err != nil {
+			g.fail(p.err)
+		}
+	})
+	return p
+}
+
+// fail records a child error and cancels the group if configured to do so.
+func (g *Group) fail(err error) {
+	g.lock.Lock()
+	g.errs = append(g.errs, err)
+	g.lock.Unlock()
+	if !g.opts.keepGoing {
+		g.cancel(err)
+	}
+}
+
+// Wait blocks until all children have settled, cancels the group's context and
+// returns the first child error, or all of them joined if WithCollectAllErrors
+// is set. It returns nil if every child was fulfilled.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel(nil)
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if len(g.errs) == 0 {
+		return nil
+	}
+	if g.opts.collectAll {
+		return errors.Join(g.errs...)
+	}
+	return g.errs[0]
+}
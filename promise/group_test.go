@@ -0,0 +1,79 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGroup(t *testing.T) {
+	t.Run("All children succeed", func(t *testing.T) {
+		g, _ := NewGroup(context.Background())
+		a := Go(g, func(context.Context) (int, error) { return 1, nil })
+		b := Go(g, func(context.Context) (string, error) { return "b", nil })
+
+		if err := g.Wait(); err != nil {
+			t.Fatalf("Expected no error, but got %v", err)
+		}
+		if val, _ := a.Await(); val != 1 {
+			t.Errorf("Expected 1, but got %v", val)
+		}
+		if val, _ := b.Await(); val != "b" {
+			t.Errorf("Expected 'b', but got %v", val)
+		}
+	})
+
+	t.Run("First failure cancels siblings", func(t *testing.T) {
+		expectedErr := errors.New("failed")
+		g, ctx := NewGroup(context.Background())
+		sibling := Go(g, func(ctx context.Context) (int, error) {
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case <-time.After(time.Second):
+				return 1, nil
+			}
+		})
+		Go(g, func(context.Context) (int, error) { return 0, expectedErr })
+
+		if err := g.Wait(); !errors.Is(err, expectedErr) {
+			t.Errorf("Expected error %v, but got %v", expectedErr, err)
+		}
+		if _, err := sibling.Await(); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected sibling to be canceled, but got %v", err)
+		}
+		if cause := context.Cause(ctx); !errors.Is(cause, expectedErr) {
+			t.Errorf("Expected cancellation cause %v, but got %v", expectedErr, cause)
+		}
+	})
+
+	t.Run("Keep going and collect all errors", func(t *testing.T) {
+		errA, errB := errors.New("a"), errors.New("b")
+		g, _ := NewGroup(context.Background(), WithoutCancelOnError(), WithCollectAllErrors())
+		Go(g, func(context.Context) (int, error) { return 0, errA })
+		Go(g, func(context.Context) (int, error) { return 0, errB })
+		ok := Go(g, func(ctx context.Context) (int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return 1, ctx.Err()
+		})
+
+		err := g.Wait()
+		if !errors.Is(err, errA) || !errors.Is(err, errB) {
+			t.Errorf("Expected both errors, but got %v", err)
+		}
+		if val, err := ok.Await(); err != nil || val != 1 {
+			t.Errorf("Expected sibling to complete with (1, nil), but got (%v, %v)", val, err)
+		}
+	})
+
+	t.Run("Panic counts as failure", func(t *testing.T) {
+		g, _ := NewGroup(context.Background())
+		Go(g, func(context.Context) (int, error) { panic("child") })
+
+		var perr *PanicError
+		if err := g.Wait(); !errors.As(err, &perr) {
+			t.Errorf("Expected a *PanicError, but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package promise
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// EventKind identifies what happened to a promise in an Event.
+type EventKind int
+
+const (
+	// EventCreated is reported when a promise is created.
+	EventCreated EventKind = iota
+	// EventFulfilled is reported when a promise is fulfilled.
+	EventFulfilled
+	// EventRejected is reported when a promise is rejected.
+	EventRejected
+	// EventPanicked is reported instead of EventRejected when a promise is
+	// rejected because its own user code panicked. The error wraps a
+	// *PanicError. Promises chained from it report a plain EventRejected.
+	EventPanicked
+)
+
+// String returns the lower-case name of the event kind.
+func (k EventKind) String() string {
+	switch k {
+	case EventCreated:
+		return "created"
+	case EventFulfilled:
+		return "fulfilled"
+	case EventRejected:
+		return "rejected"
+	case EventPanicked:
+		return "panicked"
+	default:
+		return "unknown"
+	}
+}
+
+// Event describes a step in the lifecycle of a promise, as reported to Hooks.
+type Event struct {
+	// Kind is what happened.
+	Kind EventKind
+	// Name is the name given to the promise with NewNamed or AsyncNamed, if any.
+	Name string
+	// Created is the time the promise was created. It is the zero time if no
+	// hooks were installed at that moment.
+	Created time.Time
+	// Duration is the time between creation and settlement. It is zero for
+	// EventCreated and when Created is unknown.
+	Duration time.Duration
+	// Err is the rejection error, if any.
+	Err error
+}
+
+// Hooks are callbacks invoked during the lifecycle of promises. Any of them may
+// be nil. Hooks run synchronously in the goroutine that creates or settles the
+// promise, so they should be fast and must not block. A panic in a hook is
+// recovered and discarded, so that it cannot leave the promise half settled.
+type Hooks struct {
+	// OnCreate is called when a promise is created.
+	OnCreate func(Event)
+	// OnSettle is called when a promise is fulfilled or rejected.
+	OnSettle func(Event)
+	// OnReject is called when a promise is rejected, including by a panic.
+	OnReject func(Event)
+	// OnPanic is called when a promise is rejected because its own user code
+	// panicked. It is called once per panic, not for every promise the
+	// rejection propagates to.
+	OnPanic func(Event)
+}
+
+var (
+	hooksLock   sync.Mutex
+	globalHooks atomic.Pointer[[]*Hooks] // Copy-on-write; nil when no hooks are set
+)
+
+// AddHooks installs hooks that are invoked for every promise created or
+// settled from now on, and returns a function that removes them again. It is
+// safe to call concurrently with promise operations. When no hooks are
+// installed, the cost for promises is a single atomic load per event.
+func AddHooks(h Hooks) (remove func()) {
+	hp := &h
+	hooksLock.Lock()
+	defer hooksLock.Unlock()
+
+	var hs []*Hooks
+	if cur := globalHooks.Load(); cur != nil {
+		hs = append(hs, *cur...)
+	}
+	hs = append(hs, hp)
+	globalHooks.Store(&hs)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			hooksLock.Lock()
+			defer hooksLock.Unlock()
+
+			cur := globalHooks.Load()
+			if cur == nil {
+				return
+			}
+			var hs []*Hooks
+			for _, other := range *cur {
+				if other != hp {
+					hs = append(hs, other)
+				}
+			}
+			if len(hs) == 0 {
+				globalHooks.Store(nil)
+			} else {
+				globalHooks.Store(&hs)
+			}
+		})
+	}
+}
+
+// NewNamed is like New, but gives the promise a name that is reported in the
+// events passed to Hooks.
+func NewNamed[T any](name string, executor func(resolve func(T), reject func(error))) *Promise[T] {
+	return start(newPromise[T](name, nil), executor)
+}
+
+// AsyncNamed is like Async, but gives the promise a name that is reported in
+// the events passed to Hooks.
+func AsyncNamed[T any](name string, f func() (T, error)) *Promise[T] {
+	return NewNamed(name, asyncExecutor(f))
+}
+
+// Observe installs hooks for the settlement of p only and returns p. OnCreate
+// is ignored, as p already exists. If p is already settled, the hooks are
+// invoked right away. If Event.Created is unknown, Duration is measured from
+// the call to Observe.
+func (p *Promise[T]) Observe(h Hooks) *Promise[T] {
+	since := p.created
+	if since.IsZero() {
+		since = time.Now()
+	}
+	p.onSettle(func() {
+		h.settled(p.settledEvent(since))
+	})
+	return p
+}
+
+// observeCreate reports the creation of p to the global hooks.
+func (p *Promise[T]) observeCreate() {
+	hs := globalHooks.Load()
+	if hs == nil {
+		return
+	}
+	p.created = time.Now()
+	ev := Event{Kind: EventCreated, Name: p.name, Created: p.created}
+	for _, h := range *hs {
+		callHook(h.OnCreate, ev)
+	}
+}
+
+// observeSettle reports the settlement of p to the global hooks. It must be
+// called after p has been settled.
+func (p *Promise[T]) observeSettle() {
+	hs := globalHooks.Load()
+	if hs == nil {
+		return
+	}
+	ev := p.settledEvent(p.created)
+	for _, h := range *hs {
+		h.settled(ev)
+	}
+}
+
+// settledEvent builds the event describing the settlement of p, measuring the
+// duration from since.
+func (p *Promise[T]) settledEvent(since time.Time) Event {
+	ev := Event{Kind: EventFulfilled, Name: p.name, Created: p.created, Err: p.err}
+	if !since.IsZero() {
+		ev.Duration = time.Since(since)
+	}
+	if p.state == Rejected {
+		ev.Kind = EventRejected
+		if p.panicked {
+			ev.Kind = EventPanicked
+		}
+	}
+	return ev
+}
+
+// settled dispatches a settlement event to the matching hooks.
+func (h *Hooks) settled(ev Event) {
+	callHook(h.OnSettle, ev)
+	if ev.Kind == EventRejected || ev.Kind == EventPanicked {
+		callHook(h.OnReject, ev)
+	}
+	if ev.Kind == EventPanicked {
+		callHook(h.OnPanic, ev)
+	}
+}
+
+// callHook calls fn with ev, if fn is set, and discards any panic.
+func callHook(fn func(Event), ev Event) {
+	if fn == nil {
+		return
+	}
+	defer func() {
+		_ = recover()
+	}()
+	fn(ev)
+}
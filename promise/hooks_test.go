@@ -0,0 +1,184 @@
+package promise
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recorder collects the events reported for promises with a given name.
+type recorder struct {
+	name   string
+	lock   sync.Mutex
+	events map[string][]Event
+}
+
+func newRecorder(name string) *recorder {
+	return &recorder{name: name, events: make(map[string][]Event)}
+}
+
+func (r *recorder) hook(kind string) func(Event) {
+	return func(ev Event) {
+		if ev.Name != r.name {
+			return
+		}
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		r.events[kind] = append(r.events[kind], ev)
+	}
+}
+
+func (r *recorder) hooks() Hooks {
+	return Hooks{
+		OnCreate: r.hook("create"),
+		OnSettle: r.hook("settle"),
+		OnReject: r.hook("reject"),
+		OnPanic:  r.hook("panic"),
+	}
+}
+
+func (r *recorder) count(kind string) int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return len(r.events[kind])
+}
+
+func TestHooks(t *testing.T) {
+	t.Run("Global hooks", func(t *testing.T) {
+		rec := newRecorder("global")
+		remove := AddHooks(rec.hooks())
+
+		ok := AsyncNamed("global", func() (int, error) {
+			time.Sleep(5 * time.Millisecond)
+			return 1, nil
+		})
+		failed := AsyncNamed("global", func() (int, error) { return 0, errors.New("failed") })
+		panicked := NewNamed("global", func(func(int), func(error)) { panic("boom") })
+		ok.Await()
+		failed.Await()
+		panicked.Await()
+		remove()
+
+		if n := rec.count("create"); n != 3 {
+			t.Errorf("Expected 3 create events, but got %d", n)
+		}
+		if n := rec.count("settle"); n != 3 {
+			t.Errorf("Expected 3 settle events, but got %d", n)
+		}
+		if n := rec.count("reject"); n != 2 {
+			t.Errorf("Expected 2 reject events, but got %d", n)
+		}
+		if n := rec.count("panic"); n != 1 {
+			t.Errorf("Expected 1 panic event, but got %d", n)
+		}
+		for _, ev := range rec.events["settle"] {
+			if ev.Created.IsZero() || ev.Duration <= 0 {
+				t.Errorf("Expected timings in %+v", ev)
+			}
+		}
+
+		AsyncNamed("global", func() (int, error) { return 1, nil }).Await()
+		if n := rec.count("create"); n != 3 {
+			t.Errorf("Expected no events after removal, but got %d create events", n)
+		}
+	})
+
+	t.Run("Per-promise hooks", func(t *testing.T) {
+		rec := newRecorder("")
+		p := Async(func() (int, error) { return 0, errors.New("failed") }).Observe(rec.hooks())
+		p.Await()
+
+		// Observe runs as a callback, which may happen after Await returns.
+		deadline := time.Now().Add(time.Second)
+		for rec.count("reject") == 0 && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		if rec.count("reject") != 1 || rec.count("create") != 0 {
+			t.Errorf("Expected only a reject event, but got %v", rec.events)
+		}
+	})
+
+	t.Run("Panic is reported once per chain", func(t *testing.T) {
+		rec := newRecorder("")
+		p := Async(func() (int, error) { panic("boom") }).Observe(rec.hooks())
+		p = p.Then(func(v int) int { return v }).Observe(rec.hooks())
+		p = p.Then(func(v int) int { return v }).Observe(rec.hooks())
+		p.Await()
+
+		deadline := time.Now().Add(time.Second)
+		for rec.count("reject") < 3 && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		if n := rec.count("reject"); n != 3 {
+			t.Errorf("Expected 3 reject events, but got %d", n)
+		}
+		if n := rec.count("panic"); n != 1 {
+			t.Errorf("Expected 1 panic event, but got %d", n)
+		}
+	})
+
+	t.Run("Panicking hooks", func(t *testing.T) {
+		remove := AddHooks(Hooks{
+			OnCreate: func(Event) { panic("create") },
+			OnSettle: func(Event) { panic("settle") },
+		})
+		defer remove()
+
+		p := Async(func() (int, error) { return 1, nil }).
+			Observe(Hooks{OnSettle: func(Event) { panic("observe") }}).
+			Then(func(v int) int { return v + 1 })
+		select {
+		case <-p.Done():
+		case <-time.After(time.Second):
+			t.Fatal("Expected the chain to settle despite panicking hooks")
+		}
+		if v, err := p.Await(); v != 2 || err != nil {
+			t.Errorf("Expected (2, nil), but got (%v, %v)", v, err)
+		}
+	})
+
+	t.Run("State is consistent with TryResult during hooks", func(t *testing.T) {
+		entered, release := make(chan struct{}), make(chan struct{})
+		remove := AddHooks(Hooks{OnSettle: func(ev Event) {
+			if ev.Name == "slow" {
+				close(entered)
+				<-release
+			}
+		}})
+		defer remove()
+
+		p := AsyncNamed("slow", func() (int, error) { return 1, nil })
+		<-entered
+		if s := p.State(); s != Pending {
+			t.Errorf("Expected %v while hooks run, but got %v", Pending, s)
+		}
+		if _, ok := p.TryResult(); ok {
+			t.Error("Expected TryResult to report a pending promise while hooks run")
+		}
+		close(release)
+
+		<-p.Done()
+		if r, ok := p.TryResult(); p.State() != Fulfilled || !ok || r.Unwrap() != 1 {
+			t.Errorf("Expected a fulfilled result 1, but got %v (ok=%v, state=%v)", r, ok, p.State())
+		}
+	})
+
+	t.Run("Concurrent registration", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				remove := AddHooks(Hooks{OnSettle: func(Event) {}})
+				Async(func() (int, error) { return 1, nil }).Await()
+				remove()
+			}()
+		}
+		wg.Wait()
+
+		if globalHooks.Load() != nil {
+			t.Error("Expected all hooks to be removed")
+		}
+	})
+}
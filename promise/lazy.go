@@ -0,0 +1,55 @@
+package promise
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy is a promise whose executor is deferred until the result is first
+// requested, either by Await or by an explicit call to Start. The executor runs
+// at most once and its outcome is memoized, so every later call observes the
+// same value or error.
+//
+// Lazy is useful for expensive work attached to request-scoped structures,
+// where the work should not be triggered if nobody consumes the result.
+type Lazy[T any] struct {
+	once     sync.Once
+	started  atomic.Bool
+	executor func(resolve func(T), reject func(error))
+	p        *Promise[T]
+}
+
+// NewLazy creates a new Lazy promise. The executor has the same contract as the
+// one passed to New, but it is not run until Start or Await is called.
+func NewLazy[T any](executor func(resolve func(T), reject func(error))) *Lazy[T] {
+	return &Lazy[T]{executor: executor}
+}
+
+// LazyAsync is the deferred counterpart of Async. It wraps a function returning
+// (T, error) into a Lazy promise.
+func LazyAsync[T any](f func() (T, error)) *Lazy[T] {
+	return NewLazy(asyncExecutor(f))
+}
+
+// Start runs the executor if it has not been started yet and returns the
+// underlying Promise. It is safe to call Start concurrently and repeatedly;
+// all callers receive the same Promise.
+func (l *Lazy[T]) Start() *Promise[T] {
+	l.once.Do(func() {
+		l.p = New(l.executor)
+		l.executor = nil // Release the closure once it has been handed off.
+		l.started.Store(true)
+	})
+	return l.p
+}
+
+// Started reports whether the executor has been started.
+func (l *Lazy[T]) Started() bool {
+	return l.started.Load()
+}
+
+// Await starts the executor if necessary and blocks until the result is
+// available.
+func (l *Lazy[T]) Await() (T, error) {
+	return l.Start().Await()
+}
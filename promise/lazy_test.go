@@ -0,0 +1,62 @@
+package promise
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestLazy(t *testing.T) {
+	t.Run("Does not run until awaited", func(t *testing.T) {
+		var calls atomic.Int32
+		l := LazyAsync(func() (int, error) {
+			calls.Add(1)
+			return 42, nil
+		})
+
+		if l.Started() {
+			t.Error("Expected lazy promise not to be started")
+		}
+		if n := calls.Load(); n != 0 {
+			t.Errorf("Expected executor not to run, but it ran %d times", n)
+		}
+
+		val, err := l.Await()
+
+		if err != nil || val != 42 {
+			t.Errorf("Expected (42, nil), but got (%v, %v)", val, err)
+		}
+		if !l.Started() {
+			t.Error("Expected lazy promise to be started after Await")
+		}
+	})
+
+	t.Run("Runs once and memoizes the result", func(t *testing.T) {
+		var calls atomic.Int32
+		expectedErr := errors.New("failed")
+		l := LazyAsync(func() (int, error) {
+			calls.Add(1)
+			return 0, expectedErr
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := l.Await(); !errors.Is(err, expectedErr) {
+					t.Errorf("Expected error %v, but got %v", expectedErr, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if n := calls.Load(); n != 1 {
+			t.Errorf("Expected executor to run once, but it ran %d times", n)
+		}
+		if l.Start() != l.Start() {
+			t.Error("Expected Start to return the same promise")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// ErrLimitExceeded is returned by Limiter.Wait when the wait for a token would
+// outlast the context's deadline.
+var ErrLimitExceeded = errors.New("promise: rate limit wait exceeds context deadline")
+
+// ErrLimiterExhausted is returned by Limiter.Wait when the bucket is empty and
+// the limiter's rate is zero or negative, so no token will ever become
+// available.
+var ErrLimiterExhausted = errors.New("promise: rate limiter is exhausted and never refills")
+
+// Limiter is a token-bucket rate limiter. The bucket holds up to burst tokens
+// and is refilled at rate tokens per second; each scheduled call consumes one
+// token. Calls are scheduled through it with Schedule, which protects
+// downstream services from the fan-out created by All over large slices.
+//
+// A Limiter is safe for concurrent use.
+type Limiter struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// NewLimiter creates a Limiter that allows rate events per second with bursts
+// of up to burst events. The bucket starts full. A rate of math.Inf(1) disables
+// limiting. A zero or negative rate never refills the bucket, so only the
+// initial burst is allowed; after that Wait fails with ErrLimiterExhausted.
+// A burst smaller than one is treated as one.
+func NewLimiter(rate float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	l := &Limiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+	l.last = l.now()
+	return l
+}
+
+// Wait blocks until a token is available or ctx is done. If the wait would
+// outlast the context's deadline, Wait fails immediately with
+// ErrLimitExceeded without consuming a token. If no token will ever become
+// available, it fails with ErrLimiterExhausted.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay, err := l.reserve(ctx)
+	if err != nil {
+		return err
+	}
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token, possibly in advance, and returns how long the caller
+// must wait before using it. Without taking the token, it fails with
+// ErrLimitExceeded if the wait would outlast the context's deadline, or with
+// ErrLimiterExhausted if the token would never become available.
+func (l *Limiter) reserve(ctx context.Context) (time.Duration, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if math.IsInf(l.rate, 1) {
+		return 0, nil
+	}
+
+	now := l.now()
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		l.last = now
+	}
+
+	var delay time.Duration
+	if l.tokens < 1 {
+		if l.rate <= 0 {
+			return 0, ErrLimiterExhausted
+		}
+		delay = time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+	}
+	if deadline, ok := ctx.Deadline(); ok && now.Add(delay).After(deadline) {
+		return 0, ErrLimitExceeded
+	}
+	l.tokens--
+	return delay, nil
+}
+
+// cancel returns a token taken by a reservation that was not used.
+func (l *Limiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Schedule runs fn as a promise once l grants a token. If ctx is done before
+// that, the promise is rejected with the context's error and fn is not called.
+func Schedule[T any](ctx context.Context, l *Limiter, fn func() (T, error)) *Promise[T] {
+	return Async(func() (T, error) {
+		if err := l.Wait(ctx); err != nil {
+			var zero T
+			return zero, err
+		}
+		return fn()
+	})
+}
+
+// KeyedLimiter maintains an independent Limiter per key, all with the same
+// rate and burst, for example to limit calls per tenant or per host.
+//
+// A KeyedLimiter is safe for concurrent use.
+type KeyedLimiter[K comparable] struct {
+	lock     sync.Mutex
+	rate     float64
+	burst    int
+	limiters map[K]*Limiter
+}
+
+// NewKeyedLimiter creates a KeyedLimiter whose limiters allow rate events per
+// second with bursts of up to burst events.
+func NewKeyedLimiter[K comparable](rate float64, burst int) *KeyedLimiter[K] {
+	return &KeyedLimiter[K]{
+		rate:     rate,
+		burst:    burst,
+		limiters: make(map[K]*Limiter),
+	}
+}
+
+// For returns the Limiter for key, creating it on first use.
+func (k *KeyedLimiter[K]) For(key K) *Limiter {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	l, ok := k.limiters[key]
+	if !ok {
+		l = NewLimiter(k.rate, k.burst)
+		k.limiters[key] = l
+	}
+	return l
+}
+
+// Forget drops the Limiter for key, e.g. when the key is no longer in use.
+// The next call to For starts with a full bucket.
+func (k *KeyedLimiter[K]) Forget(key K) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	delete(k.limiters, key)
+}
@@ -0,0 +1,136 @@
+package promise
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Run("Burst then rate", func(t *testing.T) {
+		now := time.Unix(0, 0)
+		l := NewLimiter(10, 2)
+		l.now = func() time.Time { return now }
+		l.last = now
+
+		for i := 0; i < 2; i++ {
+			if delay, err := l.reserve(context.Background()); err != nil || delay != 0 {
+				t.Errorf("Expected burst token %d without delay, but got %v (err=%v)", i, delay, err)
+			}
+		}
+		if delay, _ := l.reserve(context.Background()); delay != 100*time.Millisecond {
+			t.Errorf("Expected a 100ms delay, but got %v", delay)
+		}
+
+		now = now.Add(time.Second)
+		if delay, _ := l.reserve(context.Background()); delay != 0 {
+			t.Errorf("Expected refilled tokens, but got a %v delay", delay)
+		}
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		l := NewLimiter(1, 1)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Expected the first token to be granted, but got %v", err)
+		}
+		if err := l.Wait(ctx); !errors.Is(err, ErrLimitExceeded) {
+			t.Errorf("Expected ErrLimitExceeded, but got %v", err)
+		}
+	})
+
+	t.Run("Cancellation returns the token", func(t *testing.T) {
+		l := NewLimiter(1, 1)
+		_ = l.Wait(context.Background())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+		if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, but got %v", err)
+		}
+		if l.tokens < -0.1 {
+			t.Errorf("Expected the reserved token to be returned, but tokens are %v", l.tokens)
+		}
+	})
+
+	t.Run("Zero rate allows only the burst", func(t *testing.T) {
+		l := NewLimiter(0, 1)
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("Expected the burst token to be granted, but got %v", err)
+		}
+		if err := l.Wait(context.Background()); !errors.Is(err, ErrLimiterExhausted) {
+			t.Errorf("Expected ErrLimiterExhausted, but got %v", err)
+		}
+	})
+
+	t.Run("Unlimited", func(t *testing.T) {
+		l := NewLimiter(math.Inf(1), 1)
+		for i := 0; i < 100; i++ {
+			if err := l.Wait(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestSchedule(t *testing.T) {
+	t.Run("Runs through the limiter", func(t *testing.T) {
+		l := NewLimiter(100, 1)
+		start := time.Now()
+		ps := make([]*Promise[int], 3)
+		for i := range ps {
+			ps[i] = Schedule(context.Background(), l, func() (int, error) { return i, nil })
+		}
+
+		vals, err := All(ps...).Await()
+
+		if err != nil || len(vals) != 3 || vals[2] != 2 {
+			t.Errorf("Expected ([0 1 2], nil), but got (%v, %v)", vals, err)
+		}
+		if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+			t.Errorf("Expected calls to be spread out by the limiter, but took %v", elapsed)
+		}
+	})
+
+	t.Run("Canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		called := false
+
+		_, err := Schedule(ctx, NewLimiter(1, 1), func() (int, error) {
+			called = true
+			return 0, nil
+		}).Await()
+
+		if !errors.Is(err, context.Canceled) || called {
+			t.Errorf("Expected context.Canceled without calling fn, but got %v (called=%v)", err, called)
+		}
+	})
+
+	t.Run("Per-key limits", func(t *testing.T) {
+		kl := NewKeyedLimiter[string](1, 1)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		if _, err := Schedule(ctx, kl.For("a"), func() (int, error) { return 1, nil }).Await(); err != nil {
+			t.Fatalf("Expected key 'a' to be granted, but got %v", err)
+		}
+		if _, err := Schedule(ctx, kl.For("b"), func() (int, error) { return 1, nil }).Await(); err != nil {
+			t.Errorf("Expected key 'b' to have its own bucket, but got %v", err)
+		}
+		if _, err := Schedule(ctx, kl.For("a"), func() (int, error) { return 1, nil }).Await(); !errors.Is(err, ErrLimitExceeded) {
+			t.Errorf("Expected key 'a' to be limited, but got %v", err)
+		}
+		kl.Forget("a")
+		if kl.For("a").tokens != 1 {
+			t.Error("Expected a fresh limiter after Forget")
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package promise
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/goexts/generic/configure"
+)
+
+// MemoOption configures a Memo.
+type MemoOption func(*memoOptions)
+
+type memoOptions struct {
+	ttl      time.Duration
+	capacity int
+	now      func() time.Time
+}
+
+// WithMemoTTL sets how long a successful result stays cached. A zero or
+// negative duration, the default, keeps results until they are evicted or
+// forgotten.
+func WithMemoTTL(ttl time.Duration) MemoOption {
+	return func(o *memoOptions) {
+		o.ttl = ttl
+	}
+}
+
+// WithMemoCapacity limits the number of keys held by the cache. When the limit
+// is exceeded the least recently used settled key is evicted. Keys whose load
+// is still in flight are never evicted, so the cache may hold more keys than
+// the limit while many loads are pending. A zero or negative capacity, the
+// default, means no limit.
+func WithMemoCapacity(n int) MemoOption {
+	return func(o *memoOptions) {
+		o.capacity = n
+	}
+}
+
+// WithMemoClock sets the function the cache uses to read the current time when
+// applying the TTL. It allows the cache to be tested with a fake clock. The
+// default is time.Now.
+func WithMemoClock(now func() time.Time) MemoOption {
+	return func(o *memoOptions) {
+		o.now = now
+	}
+}
+
+// Memo is a keyed asynchronous cache built on Promise. Concurrent calls to Get
+// for the same key share a single in-flight promise, so the loader runs at most
+// once per key at a time. Successful results are cached, optionally for a
+// limited time and up to a limited number of keys; rejections are never
+// cached, so the next Get after a failure calls the loader again.
+//
+// A Memo is safe for concurrent use.
+type Memo[K comparable, V any] struct {
+	lock    sync.Mutex
+	load    func(K) *Promise[V]
+	opts    memoOptions
+	entries map[K]*list.Element
+	lru     *list.List // Front is the most recently used entry
+}
+
+type memoEntry[K comparable, V any] struct {
+	key     K
+	p       *Promise[V]
+	expires time.Time // Zero while in flight or if there is no TTL
+}
+
+// NewMemo creates a new Memo that calls load to produce the value of a key
+// that is not cached.
+func NewMemo[K comparable, V any](load func(key K) *Promise[V], opts ...MemoOption) *Memo[K, V] {
+	m := &Memo[K, V]{
+		load:    load,
+		opts:    memoOptions{now: time.Now},
+		entries: make(map[K]*list.Element),
+		lru:     list.New(),
+	}
+	configure.Apply(&m.opts, opts)
+	return m
+}
+
+// Get returns the promise for key. If a successful result is cached or a load
+// is in flight, the existing promise is returned; otherwise the loader is
+// called. A panic in the loader rejects the returned promise with a
+// *PanicError.
+func (m *Memo[K, V]) Get(key K) *Promise[V] {
+	m.lock.Lock()
+	if elem, ok := m.entries[key]; ok {
+		e := elem.Value.(*memoEntry[K, V])
+		if e.expires.IsZero() || m.opts.now().Before(e.expires) {
+			m.lru.MoveToFront(elem)
+			m.lock.Unlock()
+			return e.p
+		}
+		m.remove(elem)
+	}
+
+	d := NewDeferred[V]()
+	e := &memoEntry[K, V]{key: key, p: d.Promise()}
+	m.entries[key] = m.lru.PushFront(e)
+	m.evict()
+	m.lock.Unlock()
+
+	// Settlement is observed before the loader runs, so that an entry is never
+	// left behind for a loader that settles synchronously.
+	e.p.onSettle(func() {
+		m.settled(e)
+	})
+	m.start(key, d)
+	return e.p
+}
+
+// start runs the loader for key and forwards its outcome to d. Only the loader
+// is protected against panics, not the settlement of d.
+func (m *Memo[K, V]) start(key K, d *Deferred[V]) {
+	p := runStep("Memo", func() *Promise[V] {
+		return m.load(key)
+	})
+	adopt(p, func(v V) { d.Resolve(v) }, func(err error) { d.Reject(err) })
+}
+
+// settled updates the cache entry once its promise has settled.
+func (m *Memo[K, V]) settled(e *memoEntry[K, V]) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	elem, ok := m.entries[e.key]
+	if !ok || elem.Value != e {
+		// The entry has been evicted or forgotten in the meantime.
+		return
+	}
+	if e.p.err != nil {
+		m.remove(elem)
+		return
+	}
+	if m.opts.ttl > 0 {
+		e.expires = m.opts.now().Add(m.opts.ttl)
+	}
+	// Now that the entry is no longer in flight, it may be evicted.
+	m.evict()
+}
+
+// Forget removes key from the cache. Callers already holding its promise are
+// not affected.
+func (m *Memo[K, V]) Forget(key K) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+}
+
+// Len returns the number of keys currently held, including in-flight loads and
+// expired entries that have not been evicted yet.
+func (m *Memo[K, V]) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.lru.Len()
+}
+
+// evict removes least recently used settled entries until the cache is within
+// its capacity. In-flight entries are skipped so that concurrent Gets for their
+// keys keep sharing a single load. The caller must hold the lock.
+func (m *Memo[K, V]) evict() {
+	if m.opts.capacity <= 0 {
+		return
+	}
+	for elem := m.lru.Back(); elem != nil && m.lru.Len() > m.opts.capacity; {
+		prev := elem.Prev()
+		if elem.Value.(*memoEntry[K, V]).p.State() != Pending {
+			m.remove(elem)
+		}
+		elem = prev
+	}
+}
+
+// remove deletes elem from the cache. The caller must hold the lock.
+func (m *Memo[K, V]) remove(elem *list.Element) {
+	e := m.lru.Remove(elem).(*memoEntry[K, V])
+	delete(m.entries, e.key)
+}
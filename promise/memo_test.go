@@ -0,0 +1,161 @@
+package promise
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestMemo(t *testing.T) {
+	t.Run("Concurrent gets share one load", func(t *testing.T) {
+		var loads atomic.Int32
+		release := make(chan struct{})
+		m := NewMemo(func(key string) *Promise[int] {
+			loads.Add(1)
+			return Async(func() (int, error) {
+				<-release
+				return len(key), nil
+			})
+		})
+
+		var wg sync.WaitGroup
+		ps := make([]*Promise[int], 10)
+		for i := range ps {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				ps[i] = m.Get("abc")
+			}(i)
+		}
+		wg.Wait()
+		close(release)
+
+		for _, p := range ps {
+			if val, err := p.Await(); err != nil || val != 3 {
+				t.Errorf("Expected (3, nil), but got (%v, %v)", val, err)
+			}
+		}
+		if n := loads.Load(); n != 1 {
+			t.Errorf("Expected 1 load, but got %d", n)
+		}
+		if _, err := m.Get("abc").Await(); err != nil || loads.Load() != 1 {
+			t.Errorf("Expected cached result, but got err=%v after %d loads", err, loads.Load())
+		}
+	})
+
+	t.Run("Rejections are not cached", func(t *testing.T) {
+		var loads atomic.Int32
+		m := NewMemo(func(int) *Promise[int] {
+			n := loads.Add(1)
+			if n == 1 {
+				return Async(func() (int, error) { return 0, errors.New("transient") })
+			}
+			return Async(func() (int, error) { return int(n), nil })
+		})
+
+		if _, err := m.Get(1).Await(); err == nil {
+			t.Fatal("Expected the first load to fail")
+		}
+		if val, err := m.Get(1).Await(); err != nil || val != 2 {
+			t.Errorf("Expected (2, nil) after retry, but got (%v, %v)", val, err)
+		}
+	})
+
+	t.Run("TTL", func(t *testing.T) {
+		clock := &fakeClock{t: time.Unix(0, 0)}
+		var loads atomic.Int32
+		m := NewMemo(func(int) *Promise[int32] {
+			return FromResult(res.Ok(loads.Add(1)))
+		}, WithMemoTTL(time.Minute), WithMemoClock(clock.Now))
+
+		if val, _ := m.Get(1).Await(); val != 1 {
+			t.Errorf("Expected 1, but got %v", val)
+		}
+		clock.Advance(30 * time.Second)
+		if val, _ := m.Get(1).Await(); val != 1 {
+			t.Errorf("Expected cached 1, but got %v", val)
+		}
+		clock.Advance(time.Minute)
+		if val, _ := m.Get(1).Await(); val != 2 {
+			t.Errorf("Expected reloaded 2, but got %v", val)
+		}
+	})
+
+	t.Run("Capacity evicts least recently used", func(t *testing.T) {
+		var loads atomic.Int32
+		m := NewMemo(func(key int) *Promise[int] {
+			loads.Add(1)
+			return FromResult(res.Ok(key))
+		}, WithMemoCapacity(2))
+
+		m.Get(1).Await()
+		m.Get(2).Await()
+		m.Get(1).Await() // 1 is now the most recently used
+		m.Get(3).Await() // evicts 2
+
+		if m.Len() != 2 {
+			t.Errorf("Expected 2 entries, but got %d", m.Len())
+		}
+		m.Get(1).Await()
+		if n := loads.Load(); n != 3 {
+			t.Errorf("Expected key 1 to stay cached (3 loads), but got %d loads", n)
+		}
+		m.Get(2).Await()
+		if n := loads.Load(); n != 4 {
+			t.Errorf("Expected key 2 to be reloaded (4 loads), but got %d loads", n)
+		}
+	})
+
+	t.Run("Capacity does not evict in-flight loads", func(t *testing.T) {
+		var loads atomic.Int32
+		pending := NewDeferred[int]()
+		m := NewMemo(func(key int) *Promise[int] {
+			loads.Add(1)
+			if key == 1 {
+				return pending.Promise()
+			}
+			return FromResult(res.Ok(key))
+		}, WithMemoCapacity(1))
+
+		p1 := m.Get(1)
+		m.Get(2).Await() // over capacity, but 1 is still in flight
+		if m.Get(1) != p1 {
+			t.Error("Expected the in-flight promise for key 1 to be shared")
+		}
+		if n := loads.Load(); n != 2 {
+			t.Errorf("Expected 2 loads, but got %d", n)
+		}
+
+		pending.Resolve(1)
+		p1.Await()
+		if m.Len() != 1 {
+			t.Errorf("Expected the cache to shrink back to 1 entry, but got %d", m.Len())
+		}
+	})
+
+	t.Run("Forget and loader panic", func(t *testing.T) {
+		m := NewMemo(func(int) *Promise[int] {
+			panic("loader")
+		})
+
+		_, err := m.Get(1).Await()
+		var perr *PanicError
+		if !errors.As(err, &perr) || perr.Stage != "Memo" {
+			t.Errorf("Expected a *PanicError from stage 'Memo', but got %v", err)
+		}
+		if m.Len() != 0 {
+			t.Errorf("Expected the failed entry to be dropped, but got %d entries", m.Len())
+		}
+
+		ok := NewMemo(func(key int) *Promise[int] { return FromResult(res.Ok(key)) })
+		ok.Get(1).Await()
+		ok.Forget(1)
+		if ok.Len() != 0 {
+			t.Errorf("Expected no entries after Forget, but got %d", ok.Len())
+		}
+	})
+}
@@ -3,32 +3,75 @@ package promise
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/goexts/generic/res"
+)
+
+// State describes the settlement state of a Promise.
+type State int
+
+const (
+	// Pending is the initial state; the promise is neither fulfilled nor rejected.
+	Pending State = iota
+	// Fulfilled means the promise completed successfully and holds a value.
+	Fulfilled
+	// Rejected means the promise failed and holds an error.
+	Rejected
 )
 
+// String returns the lower-case name of the state.
+func (s State) String() string {
+	switch s {
+	case Pending:
+		return "pending"
+	case Fulfilled:
+		return "fulfilled"
+	case Rejected:
+		return "rejected"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
 // Promise represents the eventual completion (or failure) of an asynchronous
 // operation and its resulting value. It is a generic, type-safe implementation
 // inspired by the JavaScript Promise API.
+//
+// Callbacks attached with Then, Catch, Finally and the related helpers do not
+// occupy a goroutine while they wait. They are registered on the promise and,
+// once it settles, each runs in a goroutine of its own unless an Executor has
+// been attached with WithExecutor.
 type Promise[T any] struct {
-	lock    sync.Mutex
-	value   T
-	err     error
-	done    chan struct{}
-	settled bool // To prevent multiple resolves/rejects
+	lock      sync.Mutex
+	value     T
+	err       error
+	done      chan struct{}
+	state     State      // To prevent multiple resolves/rejects
+	callbacks []callback // Run once, when the promise settles
+	exec      Executor   // Runs callbacks; nil means a goroutine per callback
+	name      string     // Reported to hooks
+	panicked  bool       // Rejected by a panic recovered in this promise's own stage
+	created   time.Time  // Set only if hooks were installed at creation
+	handled   atomic.Bool
+	rejection atomic.Pointer[rejection] // Set only if unhandled rejections are tracked
 }
 
 // New creates a new Promise. The provided executor function is executed in a new
 // goroutine. The executor receives `resolve` and `reject` functions to control
 // the promise's outcome.
 func New[T any](executor func(resolve func(T), reject func(error))) *Promise[T] {
-	p := &Promise[T]{
-		done: make(chan struct{}),
-	}
+	return start(newPending[T](nil), executor)
+}
 
+// start runs executor for p in a new goroutine and returns p.
+func start[T any](p *Promise[T], executor func(resolve func(T), reject func(error))) *Promise[T] {
 	go func() {
 		defer func() {
 			if r := recover(); r != nil {
 				// Automatically reject if the executor panics.
-				p.reject(fmt.Errorf("promise executor panicked: %v", r))
+				p.rejectPanic(newPanicError("executor", r))
 			}
 		}()
 		executor(p.resolve, p.reject)
@@ -37,6 +80,102 @@ func New[T any](executor func(resolve func(T), reject func(error))) *Promise[T]
 	return p
 }
 
+// newPending creates a pending promise without starting any goroutine. Callbacks
+// registered on it run on exec.
+func newPending[T any](exec Executor) *Promise[T] {
+	return newPromise[T]("", exec)
+}
+
+// newPromise is like newPending, but also names the promise.
+func newPromise[T any](name string, exec Executor) *Promise[T] {
+	p := &Promise[T]{
+		done: make(chan struct{}),
+		exec: exec,
+		name: name,
+	}
+	p.observeCreate()
+	return p
+}
+
+// chain registers a continuation on p and returns the promise it settles. The
+// continuation receives p's outcome once p is settled. A panic in the
+// continuation rejects the returned promise with a *PanicError for the given
+// stage. The returned promise inherits p's executor.
+//
+// Only f itself is protected: an outcome reported while f runs is applied
+// after it has returned, so that a panic raised while settling the returned
+// promise, e.g. by an Executor further down the chain, is not mistaken for a
+// panic of this stage.
+func chain[T, K any](p *Promise[T], stage string, f func(val T, err error, resolve func(K), reject func(error))) *Promise[K] {
+	next := newPending[K](p.exec)
+	p.subscribe(func() {
+		out := &stepOutcome[K]{p: next, running: true}
+		perr := protect(stage, func() {
+			f(p.value, p.err, out.resolve, out.reject)
+		})
+		out.finish(perr)
+	})
+	return next
+}
+
+// protect calls fn and returns a *PanicError for stage if it panics.
+func protect(stage string, fn func()) (perr *PanicError) {
+	defer func() {
+		if r := recover(); r != nil {
+			perr = newPanicError(stage, r)
+		}
+	}()
+	fn()
+	return nil
+}
+
+// stepOutcome holds the outcome a continuation reports while it is running,
+// until finish settles p with it. Outcomes reported afterwards, e.g. by an
+// adopted promise, settle p directly.
+type stepOutcome[T any] struct {
+	lock    sync.Mutex
+	p       *Promise[T]
+	running bool
+	settle  func() // The first outcome reported while running
+}
+
+func (o *stepOutcome[T]) resolve(value T) {
+	o.deliver(func() { o.p.resolve(value) })
+}
+
+func (o *stepOutcome[T]) reject(err error) {
+	o.deliver(func() { o.p.reject(err) })
+}
+
+func (o *stepOutcome[T]) deliver(settle func()) {
+	o.lock.Lock()
+	if o.running {
+		if o.settle == nil {
+			o.settle = settle
+		}
+		o.lock.Unlock()
+		return
+	}
+	o.lock.Unlock()
+	settle()
+}
+
+// finish settles p with the outcome reported while running, or rejects it with
+// perr if the continuation panicked before reporting one.
+func (o *stepOutcome[T]) finish(perr *PanicError) {
+	o.lock.Lock()
+	o.running = false
+	settle := o.settle
+	o.lock.Unlock()
+
+	switch {
+	case settle != nil:
+		settle()
+	case perr != nil:
+		o.p.rejectPanic(perr)
+	}
+}
+
 // Then chains a transformation to the result of a Promise.
 //
 // Parameters:
@@ -53,13 +192,7 @@ func New[T any](executor func(resolve func(T), reject func(error))) *Promise[T]
 //  2. If a panic occurs in the transformation function f, it is caught and converted to an error
 //  3. If the transformation function f returns an error, it is propagated
 func Then[T, K any](p1 *Promise[T], f func(val T) (K, error)) *Promise[K] {
-	return New(func(resolve func(K), reject func(error)) {
-		defer func() {
-			if r := recover(); r != nil {
-				reject(fmt.Errorf("panic in Then: %v", r))
-			}
-		}()
-		val, err := p1.Await()
+	return chain(p1, "Then", func(val T, err error, resolve func(K), reject func(error)) {
 		if err != nil {
 			reject(err)
 			return
@@ -73,6 +206,55 @@ func Then[T, K any](p1 *Promise[T], f func(val T) (K, error)) *Promise[K] {
 	})
 }
 
+// FlatMap chains an asynchronous step onto a fulfilled promise. Unlike Then, the
+// callback returns a Promise, possibly of a different type, whose outcome the
+// returned promise adopts. If p1 is rejected, the error is propagated without
+// calling f.
+func FlatMap[T, K any](p1 *Promise[T], f func(val T) *Promise[K]) *Promise[K] {
+	return chain(p1, "FlatMap", func(val T, err error, resolve func(K), reject func(error)) {
+		if err != nil {
+			reject(err)
+			return
+		}
+		adopt(f(val), resolve, reject)
+	})
+}
+
+// Always chains a callback that runs when p1 settles, whether it was fulfilled
+// or rejected, and whose result settles the returned promise. It is the
+// type-changing counterpart of Finally: the callback sees the outcome and
+// decides the next one.
+func Always[T, K any](p1 *Promise[T], f func(val T, err error) (K, error)) *Promise[K] {
+	return chain(p1, "Always", func(val T, err error, resolve func(K), reject func(error)) {
+		k, err := f(val, err)
+		if err != nil {
+			reject(err)
+		} else {
+			resolve(k)
+		}
+	})
+}
+
+// AlwaysWithPromise is like Always, but the callback returns a Promise whose
+// outcome the returned promise adopts.
+func AlwaysWithPromise[T, K any](p1 *Promise[T], f func(val T, err error) *Promise[K]) *Promise[K] {
+	return chain(p1, "AlwaysWithPromise", func(val T, err error, resolve func(K), reject func(error)) {
+		adopt(f(val, err), resolve, reject)
+	})
+}
+
+// adopt settles the caller's promise through resolve or reject once src is
+// settled, mirroring src's outcome.
+func adopt[T any](src *Promise[T], resolve func(T), reject func(error)) {
+	src.follow(func() {
+		if src.err != nil {
+			reject(src.err)
+		} else {
+			resolve(src.value)
+		}
+	})
+}
+
 // All creates a new Promise that resolves when all the provided promises have resolved.
 //
 // Parameters:
@@ -87,98 +269,203 @@ func Then[T, K any](p1 *Promise[T], f func(val T) (K, error)) *Promise[K] {
 //  1. If any of the input promises is rejected, the returned promise is immediately rejected with the same error
 //  2. The order of the values in the resolved slice corresponds to the order of the input promises
 func All[T any](ps ...*Promise[T]) *Promise[[]T] {
-	return New(func(resolve func([]T), reject func(error)) {
-		if len(ps) == 0 {
-			resolve([]T{})
-			return
-		}
+	all := newPending[[]T](nil)
+	if len(ps) == 0 {
+		all.resolve([]T{})
+		return all
+	}
 
-		var wg sync.WaitGroup
-		wg.Add(len(ps))
-
-		vals := make([]T, len(ps))
-		errs := make(chan error, 1) // Buffered channel to prevent goroutine leak on reject
-
-		for i, p := range ps {
-			go func(i int, p *Promise[T]) {
-				defer wg.Done()
-				val, err := p.Await()
-				if err != nil {
-					// Non-blocking send. If another goroutine has already sent an error,
-					// this will be ignored, which is the desired behavior for All.
-					select {
-					case errs <- err:
-					default:
-					}
-					return
-				}
-				vals[i] = val
-			}(i, p)
-		}
+	vals := make([]T, len(ps))
+	var remaining atomic.Int64
+	remaining.Store(int64(len(ps)))
 
-		wg.Wait()
-		close(errs)
+	for i, p := range ps {
+		p.follow(func() {
+			if p.err != nil {
+				// Only the first rejection wins; later ones are ignored by reject.
+				all.reject(p.err)
+				return
+			}
+			vals[i] = p.value
+			if remaining.Add(-1) == 0 {
+				all.resolve(vals)
+			}
+		})
+	}
+	return all
+}
 
-		if err, ok := <-errs; ok {
-			reject(err)
-		} else {
-			resolve(vals)
-		}
-	})
+// callback is a function registered to run once a promise settles.
+type callback struct {
+	fn     func()
+	inline bool // Internal bookkeeping, run on the settling goroutine
 }
 
-// resolve fulfills the promise with a value. If the promise is already settled,
-// this call is ignored.
-func (p *Promise[T]) resolve(value T) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
+// subscribe registers fn to be run on the promise's executor once the promise
+// is settled, and marks the outcome as observed. If the promise is already
+// settled, fn is handed to the executor right away.
+func (p *Promise[T]) subscribe(fn func()) {
+	p.markHandled()
+	p.register(callback{fn: fn})
+}
 
-	if p.settled {
+// onSettle registers fn to be run synchronously once the promise is settled,
+// on the goroutine that settles it, or right away if it is already settled. It
+// does not mark the outcome as observed. It is used for internal bookkeeping
+// that must not block and does not consume the outcome.
+func (p *Promise[T]) onSettle(fn func()) {
+	p.register(callback{fn: fn, inline: true})
+}
+
+// follow is like subscribe, but runs fn synchronously like onSettle. It is
+// used to forward an outcome to other promises or channels without running
+// user code.
+func (p *Promise[T]) follow(fn func()) {
+	p.markHandled()
+	p.onSettle(fn)
+}
+
+// register adds cb to the callbacks of a pending promise, or runs it if the
+// promise is already settled.
+func (p *Promise[T]) register(cb callback) {
+	p.lock.Lock()
+	if p.state == Pending {
+		p.callbacks = append(p.callbacks, cb)
+		p.lock.Unlock()
 		return
 	}
+	p.lock.Unlock()
+	p.run(cb)
+}
+
+// run executes a callback inline if it is internal bookkeeping, otherwise on
+// the promise's executor, or in a new goroutine if it has none.
+func (p *Promise[T]) run(cb callback) {
+	switch {
+	case cb.inline:
+		cb.fn()
+	case p.exec == nil:
+		go cb.fn()
+	default:
+		p.exec.Execute(cb.fn)
+	}
+}
+
+// settle records the outcome of the promise and runs the registered callbacks.
+// panicked reports whether the rejection comes from a panic recovered by this
+// promise, rather than one propagated from upstream. It reports false if the
+// promise was already settled.
+func (p *Promise[T]) settle(value T, err error, state State, panicked bool) bool {
+	p.lock.Lock()
+	if p.state != Pending {
+		p.lock.Unlock()
+		return false
+	}
 
 	p.value = value
-	p.settled = true
+	p.err = err
+	p.state = state
+	p.panicked = panicked
+	callbacks := p.callbacks
+	p.callbacks = nil
+	p.lock.Unlock()
+
+	// Hooks run before done is closed, so that they have observed the
+	// settlement by the time Await returns.
+	p.observeSettle()
+	if state == Rejected {
+		p.trackRejection()
+	}
 	close(p.done)
+	for _, cb := range callbacks {
+		p.run(cb)
+	}
+	return true
+}
+
+// resolve fulfills the promise with a value. If the promise is already settled,
+// this call is ignored.
+func (p *Promise[T]) resolve(value T) {
+	p.settle(value, nil, Fulfilled, false)
 }
 
 // reject rejects the promise with an error. If the promise is already settled,
 // this call is ignored.
 func (p *Promise[T]) reject(err error) {
-	p.lock.Lock()
-	defer p.lock.Unlock()
-
-	if p.settled {
-		return
-	}
+	var zero T
+	p.settle(zero, err, Rejected, false)
+}
 
-	p.err = err
-	p.settled = true
-	close(p.done)
+// rejectPanic is like reject, but for an error wrapping a panic recovered by
+// this promise's own stage. Only such promises report EventPanicked.
+func (p *Promise[T]) rejectPanic(err error) {
+	var zero T
+	p.settle(zero, err, Rejected, true)
 }
 
 // Await blocks until the promise is settled and returns the resulting value and
 // error. It is the primary way to get the result of a promise.
 func (p *Promise[T]) Await() (T, error) {
+	p.markHandled()
 	<-p.done
 	return p.value, p.err
 }
 
+// State reports the current state of the promise without blocking. It reports
+// Pending until the outcome is available to Await and TryResult, so a state
+// other than Pending guarantees that TryResult succeeds.
+func (p *Promise[T]) State() State {
+	select {
+	case <-p.done:
+		// state is written before done is closed and never changes again.
+		return p.state
+	default:
+		return Pending
+	}
+}
+
+// Done returns a channel that is closed once the promise is settled. It allows
+// a promise to take part in a select statement alongside other channels.
+func (p *Promise[T]) Done() <-chan struct{} {
+	return p.done
+}
+
+// TryResult returns the outcome of the promise without blocking. The boolean is
+// false, and the Result is the zero value, if the promise is still pending.
+func (p *Promise[T]) TryResult() (res.Result[T], bool) {
+	select {
+	case <-p.done:
+		p.markHandled()
+		return res.Of(p.value, p.err), true
+	default:
+		return res.Result[T]{}, false
+	}
+}
+
+// WithExecutor returns a promise that settles with the same outcome as p, but
+// whose callbacks, and those of every promise chained from it, run on exec
+// instead of in a goroutine each.
+func (p *Promise[T]) WithExecutor(exec Executor) *Promise[T] {
+	next := newPending[T](exec)
+	p.follow(func() {
+		next.settle(p.value, p.err, p.state, false)
+	})
+	return next
+}
+
 // Then attaches a callback that executes when the promise is fulfilled.
 // It returns a new promise that resolves with the result of the onFulfilled callback.
 // If the original promise is rejected, the new promise is rejected with the same error.
+//
+// onFulfilled runs in a goroutine of its own once p settles, even if p is
+// already settled, unless an Executor has been attached with WithExecutor. The
+// same applies to every other chaining method.
 func (p *Promise[T]) Then(onFulfilled func(T) T) *Promise[T] {
-	return New(func(resolve func(T), reject func(error)) {
-		val, err := p.Await()
+	return chain(p, "Then", func(val T, err error, resolve func(T), reject func(error)) {
 		if err != nil {
 			reject(err)
 			return
 		}
-		defer func() {
-			if r := recover(); r != nil {
-				reject(fmt.Errorf("panic in Then: %v", r))
-			}
-		}()
 		resolve(onFulfilled(val))
 	})
 }
@@ -186,25 +473,13 @@ func (p *Promise[T]) Then(onFulfilled func(T) T) *Promise[T] {
 // ThenWithPromise is like Then, but the callback returns a new Promise.
 // This allows for chaining of asynchronous operations.
 func (p *Promise[T]) ThenWithPromise(onFulfilled func(T) *Promise[T]) *Promise[T] {
-	return New(func(resolve func(T), reject func(error)) {
-		defer func() {
-			if r := recover(); r != nil {
-				reject(fmt.Errorf("panic in ThenWithPromise: %v", r))
-			}
-		}()
-		val, err := p.Await()
+	return chain(p, "ThenWithPromise", func(val T, err error, resolve func(T), reject func(error)) {
 		if err != nil {
 			reject(err)
 			return
 		}
 		// Chain the promise
-		newPromise := onFulfilled(val)
-		newVal, newErr := newPromise.Await()
-		if newErr != nil {
-			reject(newErr)
-		} else {
-			resolve(newVal)
-		}
+		adopt(onFulfilled(val), resolve, reject)
 	})
 }
 
@@ -212,13 +487,7 @@ func (p *Promise[T]) ThenWithPromise(onFulfilled func(T) *Promise[T]) *Promise[T
 // It allows for error handling and recovery. The onRejected callback can return a
 // new value to fulfill the promise, or a new error to continue the rejection chain.
 func (p *Promise[T]) Catch(onRejected func(error) (T, error)) *Promise[T] {
-	return New(func(resolve func(T), reject func(error)) {
-		defer func() {
-			if r := recover(); r != nil {
-				reject(fmt.Errorf("panic in Catch: %v", r))
-			}
-		}()
-		val, err := p.Await()
+	return chain(p, "Catch", func(val T, err error, resolve func(T), reject func(error)) {
 		if err != nil {
 			newVal, newErr := onRejected(err)
 			if newErr != nil {
@@ -232,48 +501,57 @@ func (p *Promise[T]) Catch(onRejected func(error) (T, error)) *Promise[T] {
 	})
 }
 
+// CatchWith is like Catch, but the callback returns a new Promise. This allows
+// for asynchronous recovery, such as retrying the failed operation.
+func (p *Promise[T]) CatchWith(onRejected func(error) *Promise[T]) *Promise[T] {
+	return chain(p, "CatchWith", func(val T, err error, resolve func(T), reject func(error)) {
+		if err != nil {
+			adopt(onRejected(err), resolve, reject)
+			return
+		}
+		resolve(val)
+	})
+}
+
 // Finally attaches a callback that executes when the promise is settled (either
 // fulfilled or rejected). It is useful for cleanup logic.
 // The returned promise will be settled with the same value or error as the
 // original promise, after onFinally has completed.
 func (p *Promise[T]) Finally(onFinally func()) *Promise[T] {
-	return New(func(resolve func(T), reject func(error)) {
-		val, err := p.Await()
-
-		defer func() {
-			if r := recover(); r != nil {
-				// If onFinally panics, it should not suppress the original error.
-				// We create a new error that includes both pieces of information.
-				if err != nil {
-					reject(fmt.Errorf("panic in Finally: %v (original error: %w)", r, err))
-				} else {
-					reject(fmt.Errorf("panic in Finally: %v", r))
-				}
-			}
-		}()
-
-		onFinally()
-
-		if err != nil {
-			reject(err)
-		} else {
-			resolve(val)
+	next := newPending[T](p.exec)
+	p.subscribe(func() {
+		perr := protect("Finally", onFinally)
+		switch {
+		case perr == nil:
+			next.settle(p.value, p.err, p.state, false)
+		case p.err != nil:
+			// If onFinally panics, it should not suppress the original error.
+			// We create a new error that includes both pieces of information.
+			next.rejectPanic(fmt.Errorf("%w (original error: %w)", perr, p.err))
+		default:
+			next.rejectPanic(perr)
 		}
 	})
+	return next
 }
 
 // Async is a helper function that wraps a function returning (T, error)
 // into a new Promise. This is useful for converting existing functions into
 // promise-based asynchronous operations.
 func Async[T any](f func() (T, error)) *Promise[T] {
-	return New(func(resolve func(T), reject func(error)) {
+	return New(asyncExecutor(f))
+}
+
+// asyncExecutor adapts a function returning (T, error) to an executor.
+func asyncExecutor[T any](f func() (T, error)) func(resolve func(T), reject func(error)) {
+	return func(resolve func(T), reject func(error)) {
 		val, err := f()
 		if err != nil {
 			reject(err)
 		} else {
 			resolve(val)
 		}
-	})
+	}
 }
 
 // Await is a standalone function that waits for a promise to be settled.
@@ -0,0 +1,70 @@
+package promise
+
+import (
+	"testing"
+)
+
+const benchChainLength = 10
+
+// goroutineThen reproduces the former chaining strategy, where every step
+// parked a goroutine on Await of its source. It serves as the baseline for the
+// callback-based benchmarks below. Note that the goroutine stacks it parks are
+// not included in the allocation figures reported by the benchmark.
+func goroutineThen[T any](p *Promise[T], f func(T) T) *Promise[T] {
+	return New(func(resolve func(T), reject func(error)) {
+		val, err := p.Await()
+		if err != nil {
+			reject(err)
+			return
+		}
+		resolve(f(val))
+	})
+}
+
+func BenchmarkThenChain(b *testing.B) {
+	inc := func(v int) int { return v + 1 }
+
+	b.Run("callbacks", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			d := newPending[int](nil)
+			p := d
+			for j := 0; j < benchChainLength; j++ {
+				p = p.Then(inc)
+			}
+			d.resolve(0)
+			if v, _ := p.Await(); v != benchChainLength {
+				b.Fatalf("unexpected value %d", v)
+			}
+		}
+	})
+
+	b.Run("goroutines", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			d := newPending[int](nil)
+			p := d
+			for j := 0; j < benchChainLength; j++ {
+				p = goroutineThen(p, inc)
+			}
+			d.resolve(0)
+			if v, _ := p.Await(); v != benchChainLength {
+				b.Fatalf("unexpected value %d", v)
+			}
+		}
+	})
+}
+
+func BenchmarkAll(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ps := make([]*Promise[int], benchChainLength)
+		for j := range ps {
+			ps[j] = newPending[int](nil)
+			ps[j].resolve(j)
+		}
+		if _, err := All(ps...).Await(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
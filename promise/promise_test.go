@@ -2,9 +2,14 @@ package promise
 
 import (
 	"errors"
+	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/goexts/generic/res"
 )
 
 func TestPromise_AsyncAwait(t *testing.T) {
@@ -137,3 +142,240 @@ func TestPromise_ThenWithPromise(t *testing.T) {
 		}
 	})
 }
+
+func TestPromise_StateInspection(t *testing.T) {
+	t.Run("Pending then fulfilled", func(t *testing.T) {
+		release := make(chan struct{})
+		p := New(func(resolve func(int), _ func(error)) {
+			<-release
+			resolve(7)
+		})
+
+		if s := p.State(); s != Pending {
+			t.Errorf("Expected state %v, but got %v", Pending, s)
+		}
+		if _, ok := p.TryResult(); ok {
+			t.Error("Expected TryResult to report a pending promise")
+		}
+
+		close(release)
+		select {
+		case <-p.Done():
+		case <-time.After(time.Second):
+			t.Fatal("Timed out waiting for Done")
+		}
+
+		if s := p.State(); s != Fulfilled {
+			t.Errorf("Expected state %v, but got %v", Fulfilled, s)
+		}
+		r, ok := p.TryResult()
+		if !ok || !r.IsOk() || r.Unwrap() != 7 {
+			t.Errorf("Expected fulfilled result 7, but got %v (ok=%v)", r, ok)
+		}
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		expectedErr := errors.New("rejected")
+		p := Async(func() (int, error) {
+			return 0, expectedErr
+		})
+		<-p.Done()
+
+		if s := p.State(); s != Rejected {
+			t.Errorf("Expected state %v, but got %v", Rejected, s)
+		}
+		r, ok := p.TryResult()
+		if !ok || !errors.Is(r.Err(), expectedErr) {
+			t.Errorf("Expected rejected result, but got %v (ok=%v)", r, ok)
+		}
+	})
+
+	t.Run("State names", func(t *testing.T) {
+		if Pending.String() != "pending" || Fulfilled.String() != "fulfilled" || Rejected.String() != "rejected" {
+			t.Error("Unexpected state names")
+		}
+	})
+}
+
+func TestPromise_CallbackChaining(t *testing.T) {
+	t.Run("Pending chain does not park goroutines", func(t *testing.T) {
+		release := make(chan struct{})
+		src := New(func(resolve func(int), _ func(error)) {
+			<-release
+			resolve(0)
+		})
+
+		before := runtime.NumGoroutine()
+		p := src
+		for i := 0; i < 100; i++ {
+			p = p.Then(func(v int) int { return v + 1 }).
+				Catch(func(err error) (int, error) { return 0, err }).
+				Finally(func() {})
+		}
+		after := runtime.NumGoroutine()
+		close(release)
+
+		if after > before {
+			t.Errorf("Expected no extra goroutines for chained callbacks, but got %d more", after-before)
+		}
+		if val, err := p.Await(); err != nil || val != 100 {
+			t.Errorf("Expected (100, nil), but got (%v, %v)", val, err)
+		}
+	})
+
+	t.Run("Callbacks on a settled promise are not reentrant", func(t *testing.T) {
+		var lock sync.Mutex
+		lock.Lock()
+		p := FromResult(res.Ok(1)).Then(func(v int) int {
+			lock.Lock()
+			defer lock.Unlock()
+			return v * 10
+		})
+		lock.Unlock()
+
+		if val, _ := p.Await(); val != 10 {
+			t.Errorf("Expected 10, but got %v", val)
+		}
+	})
+
+	t.Run("Callbacks run concurrently", func(t *testing.T) {
+		d := NewDeferred[int]()
+		sleep := func(v int) int {
+			time.Sleep(100 * time.Millisecond)
+			return v
+		}
+		p1 := d.Promise().Then(sleep)
+		p2 := d.Promise().Then(sleep)
+
+		start := time.Now()
+		d.Resolve(1)
+		p1.Await()
+		p2.Await()
+		if elapsed := time.Since(start); elapsed >= 190*time.Millisecond {
+			t.Errorf("Expected callbacks to run concurrently, but they took %v", elapsed)
+		}
+	})
+
+	t.Run("InlineExecutor", func(t *testing.T) {
+		p := FromResult(res.Ok(1)).WithExecutor(InlineExecutor).Then(func(v int) int { return v * 10 })
+
+		if p.State() != Fulfilled {
+			t.Fatalf("Expected chained promise to be settled, but got %v", p.State())
+		}
+		if val, _ := p.Await(); val != 10 {
+			t.Errorf("Expected 10, but got %v", val)
+		}
+	})
+
+	t.Run("WithExecutor", func(t *testing.T) {
+		var executed atomic.Int32
+		exec := ExecutorFunc(func(task func()) {
+			executed.Add(1)
+			go task()
+		})
+
+		p := Async(func() (int, error) {
+			return 1, nil
+		}).WithExecutor(exec).Then(func(v int) int {
+			return v + 1
+		}).Then(func(v int) int {
+			return v * 2
+		})
+
+		if val, err := p.Await(); err != nil || val != 4 {
+			t.Errorf("Expected (4, nil), but got (%v, %v)", val, err)
+		}
+		if n := executed.Load(); n < 2 {
+			t.Errorf("Expected callbacks to run on the executor, but it ran %d tasks", n)
+		}
+	})
+
+	t.Run("All rejects on first error", func(t *testing.T) {
+		expectedErr := errors.New("failed")
+		never := newPending[int](nil)
+
+		_, err := All(never, FromResult(res.Err[int](expectedErr))).Await()
+
+		if !errors.Is(err, expectedErr) {
+			t.Errorf("Expected error %v, but got %v", expectedErr, err)
+		}
+	})
+}
+
+func TestPromise_FlatMap(t *testing.T) {
+	t.Run("Changes type across async steps", func(t *testing.T) {
+		p := FlatMap(Async(func() (int, error) {
+			return 3, nil
+		}), func(n int) *Promise[string] {
+			return Async(func() (string, error) {
+				return strings.Repeat("a", n), nil
+			})
+		})
+
+		if val, err := p.Await(); err != nil || val != "aaa" {
+			t.Errorf("Expected ('aaa', nil), but got (%v, %v)", val, err)
+		}
+	})
+
+	t.Run("Propagates errors from either side", func(t *testing.T) {
+		expectedErr := errors.New("inner")
+		p := FlatMap(FromResult(res.Ok(1)), func(int) *Promise[string] {
+			return FromResult(res.Err[string](expectedErr))
+		})
+		if _, err := p.Await(); !errors.Is(err, expectedErr) {
+			t.Errorf("Expected error %v, but got %v", expectedErr, err)
+		}
+
+		called := false
+		p = FlatMap(FromResult(res.Err[int](expectedErr)), func(int) *Promise[string] {
+			called = true
+			return nil
+		})
+		if _, err := p.Await(); !errors.Is(err, expectedErr) || called {
+			t.Errorf("Expected error %v without calling f, but got %v (called=%v)", expectedErr, err, called)
+		}
+	})
+}
+
+func TestPromise_CatchWith(t *testing.T) {
+	attempts := 0
+	p := Async(func() (int, error) {
+		attempts++
+		return 0, errors.New("first attempt failed")
+	}).CatchWith(func(error) *Promise[int] {
+		return Async(func() (int, error) {
+			attempts++
+			return 42, nil
+		})
+	})
+
+	if val, err := p.Await(); err != nil || val != 42 {
+		t.Errorf("Expected (42, nil), but got (%v, %v)", val, err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, but got %d", attempts)
+	}
+}
+
+func TestAlways(t *testing.T) {
+	describe := func(_ int, err error) (string, error) {
+		if err != nil {
+			return "failed: " + err.Error(), nil
+		}
+		return "ok", nil
+	}
+
+	if val, _ := Always(FromResult(res.Ok(1)), describe).Await(); val != "ok" {
+		t.Errorf("Expected 'ok', but got %q", val)
+	}
+	if val, _ := Always(FromResult(res.Err[int](errors.New("boom"))), describe).Await(); val != "failed: boom" {
+		t.Errorf("Expected 'failed: boom', but got %q", val)
+	}
+
+	p := AlwaysWithPromise(FromResult(res.Ok(2)), func(n int, _ error) *Promise[int] {
+		return FromResult(res.Ok(n * 2))
+	})
+	if val, err := p.Await(); err != nil || val != 4 {
+		t.Errorf("Expected (4, nil), but got (%v, %v)", val, err)
+	}
+}
@@ -0,0 +1,54 @@
+package promise
+
+import (
+	"github.com/goexts/generic/res"
+)
+
+// Result blocks until the promise is settled and returns its outcome as a
+// res.Result. It is the res-flavoured counterpart of Await.
+func (p *Promise[T]) Result() res.Result[T] {
+	return res.Of(p.Await())
+}
+
+// FromResult creates a promise that is already settled with the outcome of r.
+// No goroutine is started.
+func FromResult[T any](r res.Result[T]) *Promise[T] {
+	p := newPending[T](nil)
+	if err := r.Err(); err != nil {
+		p.reject(err)
+	} else {
+		p.resolve(r.Unwrap())
+	}
+	return p
+}
+
+// AsyncResult is like Async, but for functions that already return a
+// res.Result.
+func AsyncResult[T any](f func() res.Result[T]) *Promise[T] {
+	return Async(func() (T, error) {
+		return f().Unpack()
+	})
+}
+
+// MapResult transforms the outcome of a promise, whether fulfilled or rejected.
+// The callback receives the settled outcome as a res.Result and the returned
+// promise settles with the Result it returns. Panics in f are converted into a
+// rejection with a *PanicError.
+func MapResult[T, K any](p *Promise[T], f func(res.Result[T]) res.Result[K]) *Promise[K] {
+	return chain(p, "MapResult", func(val T, err error, resolve func(K), reject func(error)) {
+		k, err := f(res.Of(val, err)).Unpack()
+		if err != nil {
+			reject(err)
+		} else {
+			resolve(k)
+		}
+	})
+}
+
+// ThenResult chains a callback that returns a res.Result onto a fulfilled
+// promise. If p is rejected, the error is propagated without calling f.
+func ThenResult[T, K any](p *Promise[T], f func(T) res.Result[K]) *Promise[K] {
+	return Then(p, func(val T) (K, error) {
+		return f(val).Unpack()
+	})
+}
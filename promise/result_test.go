@@ -0,0 +1,61 @@
+package promise
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestPromise_Result(t *testing.T) {
+	t.Run("Result of a fulfilled promise", func(t *testing.T) {
+		r := Async(func() (int, error) { return 5, nil }).Result()
+		if !r.IsOk() || r.Unwrap() != 5 {
+			t.Errorf("Expected Ok(5), but got %v", r)
+		}
+	})
+
+	t.Run("FromResult is settled immediately", func(t *testing.T) {
+		expectedErr := errors.New("failed")
+		ok := FromResult(res.Ok("value"))
+		failed := FromResult(res.Err[string](expectedErr))
+
+		if ok.State() != Fulfilled || failed.State() != Rejected {
+			t.Fatalf("Expected settled promises, but got %v and %v", ok.State(), failed.State())
+		}
+		if val, err := ok.Await(); err != nil || val != "value" {
+			t.Errorf("Expected ('value', nil), but got (%v, %v)", val, err)
+		}
+		if _, err := failed.Await(); !errors.Is(err, expectedErr) {
+			t.Errorf("Expected error %v, but got %v", expectedErr, err)
+		}
+	})
+
+	t.Run("MapResult sees rejections", func(t *testing.T) {
+		p := MapResult(Async(func() (int, error) {
+			return 0, errors.New("failed")
+		}), func(r res.Result[int]) res.Result[string] {
+			if r.IsErr() {
+				return res.Ok("recovered")
+			}
+			return res.Ok(strconv.Itoa(r.Unwrap()))
+		})
+
+		if val, err := p.Await(); err != nil || val != "recovered" {
+			t.Errorf("Expected ('recovered', nil), but got (%v, %v)", val, err)
+		}
+	})
+
+	t.Run("ThenResult and AsyncResult", func(t *testing.T) {
+		p := ThenResult(AsyncResult(func() res.Result[string] {
+			return res.Ok("12")
+		}), func(s string) res.Result[int] {
+			return res.Of(strconv.Atoi(s))
+		})
+
+		if val, err := p.Await(); err != nil || val != 12 {
+			t.Errorf("Expected (12, nil), but got (%v, %v)", val, err)
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package promise
+
+// Sequence runs the given promise factories strictly one after another: each
+// factory is called only after the promise of the previous one is fulfilled.
+// The returned promise resolves with the values in order, or is rejected with
+// the first error, in which case the remaining factories are not called.
+//
+// Unlike All, which waits for promises that are already running, Sequence
+// controls when each step starts, which makes it suitable for migrations and
+// rate-limited APIs.
+func Sequence[T any](factories ...func() *Promise[T]) *Promise[[]T] {
+	return New(func(resolve func([]T), reject func(error)) {
+		vals := make([]T, 0, len(factories))
+		for _, factory := range factories {
+			val, err := runStep("Sequence", factory).Await()
+			if err != nil {
+				reject(err)
+				return
+			}
+			vals = append(vals, val)
+		}
+		resolve(vals)
+	})
+}
+
+// Reduce folds items into an accumulator with an asynchronous step function.
+// Steps run strictly one after another, each receiving the accumulator
+// produced by the previous one, starting with initial. The returned promise
+// is rejected with the first error, and the remaining items are skipped.
+func Reduce[T, A any](items []T, initial A, step func(acc A, item T) *Promise[A]) *Promise[A] {
+	return New(func(resolve func(A), reject func(error)) {
+		acc := initial
+		for _, item := range items {
+			var err error
+			acc, err = runStep("Reduce", func() *Promise[A] {
+				return step(acc, item)
+			}).Await()
+			if err != nil {
+				reject(err)
+				return
+			}
+		}
+		resolve(acc)
+	})
+}
+
+// runStep calls factory and returns its promise. A panic in factory, or a nil
+// promise, results in a promise rejected with a *PanicError for stage.
+func runStep[T any](stage string, factory func() *Promise[T]) (p *Promise[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			p = newPending[T](nil)
+			p.rejectPanic(newPanicError(stage, r))
+		}
+	}()
+	p = factory()
+	if p == nil {
+		panic("nil promise")
+	}
+	return p
+}
+
+// Source turns values into the input of a pipeline. The returned channel is
+// buffered, already filled and closed, so no goroutine is started.
+func Source[T any](values ...T) <-chan *Promise[T] {
+	out := make(chan *Promise[T], len(values))
+	for _, v := range values {
+		p := newPending[T](nil)
+		p.resolve(v)
+		out <- p
+	}
+	close(out)
+	return out
+}
+
+// Pipeline connects a stage to the promises coming from in. For every input it
+// emits, in the same order, a promise for the result of stage; rejected inputs
+// are forwarded without calling stage. The output channel holds at most buffer
+// pending results, which bounds how far the stage can run ahead of its
+// consumer. The output channel is closed once in is closed and drained.
+//
+// Stages can be chained to build a multi-stage pipeline:
+//
+//	parsed := promise.Pipeline(promise.Source(lines...), 8, parse)
+//	stored := promise.Pipeline(parsed, 4, store)
+//	results, err := promise.Drain(stored).Await()
+//
+// The consumer must drain the output channel; otherwise the goroutine feeding
+// it blocks forever.
+func Pipeline[In, Out any](in <-chan *Promise[In], buffer int, stage func(In) *Promise[Out]) <-chan *Promise[Out] {
+	out := make(chan *Promise[Out], buffer)
+	go func() {
+		defer close(out)
+		for p := range in {
+			out <- chain(p, "Pipeline", func(val In, err error, resolve func(Out), reject func(error)) {
+				if err != nil {
+					reject(err)
+					return
+				}
+				adopt(stage(val), resolve, reject)
+			})
+		}
+	}()
+	return out
+}
+
+// Drain collects the results of a pipeline in order. The returned promise
+// resolves once in is closed and all its promises are fulfilled, or is
+// rejected with the first error in pipeline order. In either case in is
+// drained completely, so that upstream stages can finish.
+func Drain[T any](in <-chan *Promise[T]) *Promise[[]T] {
+	return New(func(resolve func([]T), reject func(error)) {
+		vals := []T{}
+		var firstErr error
+		for p := range in {
+			val, err := p.Await()
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			vals = append(vals, val)
+		}
+		if firstErr != nil {
+			reject(firstErr)
+			return
+		}
+		resolve(vals)
+	})
+}
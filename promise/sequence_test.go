@@ -0,0 +1,113 @@
+package promise
+
+import (
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestSequence(t *testing.T) {
+	t.Run("Runs strictly in order", func(t *testing.T) {
+		var running, maxRunning atomic.Int32
+		factory := func(v int) func() *Promise[int] {
+			return func() *Promise[int] {
+				return Async(func() (int, error) {
+					if n := running.Add(1); n > maxRunning.Load() {
+						maxRunning.Store(n)
+					}
+					defer running.Add(-1)
+					time.Sleep(time.Millisecond)
+					return v, nil
+				})
+			}
+		}
+
+		vals, err := Sequence(factory(1), factory(2), factory(3)).Await()
+
+		if err != nil || len(vals) != 3 || vals[0] != 1 || vals[2] != 3 {
+			t.Errorf("Expected ([1 2 3], nil), but got (%v, %v)", vals, err)
+		}
+		if n := maxRunning.Load(); n != 1 {
+			t.Errorf("Expected at most one running step, but got %d", n)
+		}
+	})
+
+	t.Run("Stops at the first error", func(t *testing.T) {
+		expectedErr := errors.New("failed")
+		called := false
+		_, err := Sequence(
+			func() *Promise[int] { return FromResult(res.Err[int](expectedErr)) },
+			func() *Promise[int] { called = true; return nil },
+		).Await()
+
+		if !errors.Is(err, expectedErr) || called {
+			t.Errorf("Expected error %v without further steps, but got %v (called=%v)", expectedErr, err, called)
+		}
+	})
+
+	t.Run("Nil promise is a panic", func(t *testing.T) {
+		_, err := Sequence(func() *Promise[int] { return nil }).Await()
+
+		var perr *PanicError
+		if !errors.As(err, &perr) || perr.Stage != "Sequence" {
+			t.Errorf("Expected a *PanicError from stage 'Sequence', but got %v", err)
+		}
+	})
+}
+
+func TestReduce(t *testing.T) {
+	sum, err := Reduce([]int{1, 2, 3, 4}, "", func(acc string, item int) *Promise[string] {
+		return Async(func() (string, error) {
+			return acc + strconv.Itoa(item), nil
+		})
+	}).Await()
+
+	if err != nil || sum != "1234" {
+		t.Errorf("Expected ('1234', nil), but got (%v, %v)", sum, err)
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	t.Run("Multi-stage pipeline preserves order", func(t *testing.T) {
+		parsed := Pipeline(Source("1", "2", "3"), 2, func(s string) *Promise[int] {
+			return Async(func() (int, error) { return strconv.Atoi(s) })
+		})
+		doubled := Pipeline(parsed, 1, func(n int) *Promise[int] {
+			return Async(func() (int, error) {
+				time.Sleep(time.Duration(3-n) * time.Millisecond)
+				return n * 2, nil
+			})
+		})
+
+		vals, err := Drain(doubled).Await()
+
+		if err != nil || len(vals) != 3 || vals[0] != 2 || vals[1] != 4 || vals[2] != 6 {
+			t.Errorf("Expected ([2 4 6], nil), but got (%v, %v)", vals, err)
+		}
+	})
+
+	t.Run("Errors are forwarded", func(t *testing.T) {
+		var calls atomic.Int32
+		parsed := Pipeline(Source("1", "x", "3"), 0, func(s string) *Promise[int] {
+			return Async(func() (int, error) { return strconv.Atoi(s) })
+		})
+		next := Pipeline(parsed, 0, func(n int) *Promise[int] {
+			calls.Add(1)
+			return FromResult(res.Ok(n))
+		})
+
+		_, err := Drain(next).Await()
+
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) {
+			t.Errorf("Expected a parse error, but got %v", err)
+		}
+		if n := calls.Load(); n != 2 {
+			t.Errorf("Expected the second stage to run for 2 values, but it ran %d times", n)
+		}
+	})
+}
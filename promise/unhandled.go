@@ -0,0 +1,150 @@
+package promise
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// UnhandledRejection describes a rejected promise whose error was never
+// observed by Await, Catch, a combinator or any other consumer.
+type UnhandledRejection struct {
+	// Name is the name given to the promise with NewNamed or AsyncNamed, if any.
+	Name string
+	// Err is the rejection error.
+	Err error
+}
+
+// Error implements the standard error interface.
+func (u *UnhandledRejection) Error() string {
+	if u.Name != "" {
+		return fmt.Sprintf("promise %q: unhandled rejection: %v", u.Name, u.Err)
+	}
+	return fmt.Sprintf("promise: unhandled rejection: %v", u.Err)
+}
+
+// Unwrap returns the rejection error.
+func (u *UnhandledRejection) Unwrap() error {
+	return u.Err
+}
+
+// rejection is the tracking record of a rejected promise. It does not refer
+// back to the promise, so that the promise can still be garbage-collected.
+type rejection struct {
+	handled atomic.Bool
+	info    UnhandledRejection
+}
+
+type unhandledTracker struct {
+	report  func(*UnhandledRejection)
+	lock    sync.Mutex
+	pending map[*rejection]struct{} // nil once the tracker has been stopped
+}
+
+var tracker atomic.Pointer[unhandledTracker]
+
+// TrackUnhandled enables the detection of unhandled rejections, the analogue
+// of JavaScript's unhandledrejection event. From now on, every rejected
+// promise is tracked until its error is observed. If a promise is
+// garbage-collected before that, report is called from the finalizer
+// goroutine, so it must not block; report may be nil. Pending rejections can
+// also be checked at any time with CheckUnhandled.
+//
+// Only one tracker is active at a time; a new call replaces the previous one,
+// and report is only called while its tracker is the active one. The returned
+// function disables tracking and drops the pending rejections.
+func TrackUnhandled(report func(*UnhandledRejection)) (stop func()) {
+	t := &unhandledTracker{
+		report:  report,
+		pending: make(map[*rejection]struct{}),
+	}
+	tracker.Store(t)
+	return func() {
+		tracker.CompareAndSwap(t, nil)
+		t.lock.Lock()
+		t.pending = nil
+		t.lock.Unlock()
+	}
+}
+
+// CheckUnhandled returns the rejections that have not been observed so far,
+// joined with errors.Join, or nil if there are none. Every returned rejection
+// is reported only once. It is meant to be called at the end of a test:
+//
+//	stop := promise.TrackUnhandled(nil)
+//	defer stop()
+//	// ... exercise the code under test ...
+//	if err := promise.CheckUnhandled(); err != nil {
+//		t.Error(err)
+//	}
+func CheckUnhandled() error {
+	t := tracker.Load()
+	if t == nil {
+		return nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var errs []error
+	for r := range t.pending {
+		if !r.handled.Load() {
+			errs = append(errs, &r.info)
+		}
+		delete(t.pending, r)
+	}
+	return errors.Join(errs...)
+}
+
+// markHandled records that the outcome of p has been observed.
+func (p *Promise[T]) markHandled() {
+	if p.handled.Load() {
+		return
+	}
+	p.handled.Store(true)
+	if r := p.rejection.Load(); r != nil {
+		r.handled.Store(true)
+	}
+}
+
+// trackRejection starts tracking p, which has just been rejected, if tracking
+// is enabled.
+func (p *Promise[T]) trackRejection() {
+	t := tracker.Load()
+	if t == nil {
+		return
+	}
+
+	r := &rejection{info: UnhandledRejection{Name: p.name, Err: p.err}}
+	p.rejection.Store(r)
+	// markHandled may have run concurrently before the record was published.
+	if p.handled.Load() {
+		r.handled.Store(true)
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.pending == nil {
+		// The tracker was stopped in the meantime.
+		return
+	}
+	t.pending[r] = struct{}{}
+
+	runtime.SetFinalizer(p, func(*Promise[T]) {
+		t.collected(r)
+	})
+}
+
+// collected is called when the promise tracked by r has been garbage-collected.
+func (t *unhandledTracker) collected(r *rejection) {
+	t.lock.Lock()
+	_, pending := t.pending[r]
+	delete(t.pending, r)
+	t.lock.Unlock()
+
+	if pending && !r.handled.Load() && t.report != nil && tracker.Load() == t {
+		t.report(&r.info)
+	}
+}
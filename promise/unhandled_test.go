@@ -0,0 +1,100 @@
+package promise
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestCheckUnhandled(t *testing.T) {
+	stop := TrackUnhandled(nil)
+	defer stop()
+
+	unobserved := errors.New("unobserved")
+	observed := errors.New("observed")
+
+	leaked := AsyncNamed("leaked", func() (int, error) { return 0, unobserved })
+	_, _ = Async(func() (int, error) { return 0, observed }).Await()
+	Async(func() (int, error) {
+		return 0, observed
+	}).Catch(func(error) (int, error) {
+		return 1, nil
+	}).Await()
+	_, _ = All(FromResult(res.Err[int](observed))).Await()
+
+	// Waiting on Done does not count as observing the outcome.
+	<-leaked.Done()
+
+	err := CheckUnhandled()
+
+	var u *UnhandledRejection
+	if !errors.As(err, &u) || u.Name != "leaked" {
+		t.Fatalf("Expected an unhandled rejection for 'leaked', but got %v", err)
+	}
+	if !errors.Is(err, unobserved) || errors.Is(err, observed) {
+		t.Errorf("Expected only the unobserved error to be reported, but got %v", err)
+	}
+	if err := CheckUnhandled(); err != nil {
+		t.Errorf("Expected rejections to be reported once, but got %v", err)
+	}
+}
+
+func TestTrackUnhandled_GarbageCollected(t *testing.T) {
+	reported := make(chan *UnhandledRejection, 1)
+	stop := TrackUnhandled(func(u *UnhandledRejection) {
+		reported <- u
+	})
+	defer stop()
+
+	expectedErr := errors.New("collected")
+	func() {
+		FromResult(res.Err[int](expectedErr))
+	}()
+
+	deadline := time.After(5 * time.Second)
+	for {
+		runtime.GC()
+		select {
+		case u := <-reported:
+			if !errors.Is(u, expectedErr) {
+				t.Errorf("Expected %v to be reported, but got %v", expectedErr, u)
+			}
+			return
+		case <-deadline:
+			t.Fatal("Timed out waiting for the unhandled rejection to be reported")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestTrackUnhandled_Disabled(t *testing.T) {
+	FromResult(res.Err[int](errors.New("untracked")))
+
+	if err := CheckUnhandled(); err != nil {
+		t.Errorf("Expected no tracking when disabled, but got %v", err)
+	}
+}
+
+func TestTrackUnhandled_Stopped(t *testing.T) {
+	reported := make(chan *UnhandledRejection, 1)
+	stop := TrackUnhandled(func(u *UnhandledRejection) {
+		reported <- u
+	})
+	func() {
+		FromResult(res.Err[int](errors.New("stopped")))
+	}()
+	stop()
+
+	for i := 0; i < 5; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case u := <-reported:
+		t.Errorf("Expected no report after stop, but got %v", u)
+	default:
+	}
+}
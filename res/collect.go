@@ -0,0 +1,89 @@
+package res
+
+import (
+	"errors"
+	"iter"
+)
+
+// Collect turns a slice of results into a result of a slice. It is Ok with all
+// values, in order, if every result is Ok, and otherwise carries the first
+// error.
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	vals := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsErr() {
+			return Err[[]T](r.err)
+		}
+		vals = append(vals, r.value)
+	}
+	return Ok(vals)
+}
+
+// CollectAll is like Collect, but an Err carries all errors joined with
+// errors.Join instead of only the first one.
+func CollectAll[T any](rs []Result[T]) Result[[]T] {
+	vals, errs := Partition(rs)
+	if len(errs) > 0 {
+		return Err[[]T](errors.Join(errs...))
+	}
+	return Ok(vals)
+}
+
+// Partition splits a slice of results into the values of the Ok results and
+// the errors of the Err results, each in their original order.
+func Partition[T any](rs []Result[T]) ([]T, []error) {
+	vals := make([]T, 0, len(rs))
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+		} else {
+			vals = append(vals, r.value)
+		}
+	}
+	return vals, errs
+}
+
+// CollectSeq consumes seq into a result of a slice. It stops pulling from seq at
+// the first Err and returns it.
+func CollectSeq[T any](seq iter.Seq[Result[T]]) Result[[]T] {
+	vals := []T{}
+	for r := range seq {
+		if r.IsErr() {
+			return Err[[]T](r.err)
+		}
+		vals = append(vals, r.value)
+	}
+	return Ok(vals)
+}
+
+// Unpacked adapts a sequence of results into a sequence of (value, error)
+// pairs. It stops after yielding the first error, so a range loop over it sees
+// the error as its last iteration:
+//
+//	for v, err := range res.Unpacked(rows) {
+//		if err != nil {
+//			return err
+//		}
+//		process(v)
+//	}
+func Unpacked[T any](seq iter.Seq[Result[T]]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for r := range seq {
+			if !yield(r.value, r.err) || r.IsErr() {
+				return
+			}
+		}
+	}
+}
+
+// Results adapts a sequence of (value, error) pairs into a sequence of results.
+func Results[T any](seq iter.Seq2[T, error]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for v, err := range seq {
+			if !yield(Of(v, err)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package res_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestCollect(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	ok := []res.Result[int]{res.Ok(1), res.Ok(2)}
+	mixed := []res.Result[int]{res.Ok(1), res.Err[int](errA), res.Ok(3), res.Err[int](errB)}
+
+	t.Run("Collect", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, res.Collect(ok).Unwrap())
+		assert.Equal(t, errA, res.Collect(mixed).Err())
+		assert.Equal(t, []int{}, res.Collect[int](nil).Unwrap())
+	})
+
+	t.Run("CollectAll", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, res.CollectAll(ok).Unwrap())
+		err := res.CollectAll(mixed).Err()
+		assert.ErrorIs(t, err, errA)
+		assert.ErrorIs(t, err, errB)
+	})
+
+	t.Run("Partition", func(t *testing.T) {
+		vals, errs := res.Partition(mixed)
+		assert.Equal(t, []int{1, 3}, vals)
+		assert.Equal(t, []error{errA, errB}, errs)
+	})
+}
+
+func TestCollectSeq(t *testing.T) {
+	errA := errors.New("a")
+	var pulled int
+	seq := func(yield func(res.Result[int]) bool) {
+		for _, r := range []res.Result[int]{res.Ok(1), res.Err[int](errA), res.Ok(3)} {
+			pulled++
+			if !yield(r) {
+				return
+			}
+		}
+	}
+
+	t.Run("CollectSeq stops at the first error", func(t *testing.T) {
+		pulled = 0
+		assert.Equal(t, errA, res.CollectSeq(seq).Err())
+		assert.Equal(t, 2, pulled)
+		assert.Equal(t, []int{1, 2}, res.CollectSeq(slices.Values([]res.Result[int]{res.Ok(1), res.Ok(2)})).Unwrap())
+	})
+
+	t.Run("Unpacked stops after the first error", func(t *testing.T) {
+		pulled = 0
+		var vals []int
+		var gotErr error
+		for v, err := range res.Unpacked(seq) {
+			if err != nil {
+				gotErr = err
+				continue
+			}
+			vals = append(vals, v)
+		}
+		assert.Equal(t, []int{1}, vals)
+		assert.Equal(t, errA, gotErr)
+		assert.Equal(t, 2, pulled)
+	})
+
+	t.Run("Results", func(t *testing.T) {
+		pairs := func(yield func(string, error) bool) {
+			_ = yield("x", nil) && yield("", errA)
+		}
+		var rs []res.Result[string]
+		for r := range res.Results(pairs) {
+			rs = append(rs, r)
+		}
+		assert.Equal(t, []res.Result[string]{res.Ok("x"), res.Err[string](errA)}, rs)
+	})
+}
@@ -0,0 +1,87 @@
+package res
+
+// The functions in this file are the monadic combinators of Result. They are
+// top-level functions rather than methods because Go methods cannot introduce
+// type parameters of their own.
+
+// Map transforms the value of an Ok result with f. An Err result is returned
+// unchanged, with its error carried over to the new type.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.IsErr() {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.value))
+}
+
+// FlatMap chains a function that itself returns a Result onto an Ok result.
+// An Err result short-circuits and f is not called.
+func FlatMap[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.IsErr() {
+		return Err[U](r.err)
+	}
+	return f(r.value)
+}
+
+// AndThen is an alias of FlatMap, named after its Rust counterpart. It reads
+// well in pipelines:
+//
+//	n := res.AndThen(res.AndThen(getFilename(cfg), readContent), parseNumber)
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	return FlatMap(r, f)
+}
+
+// MapErr transforms the error of an Err result with f, e.g. to add context. An
+// Ok result is returned unchanged.
+func MapErr[T any](r Result[T], f func(error) error) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Err[T](f(r.err))
+}
+
+// OrElse recovers from an Err result by calling f with its error. An Ok result
+// is returned unchanged and f is not called.
+func OrElse[T any](r Result[T], f func(error) Result[T]) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return f(r.err)
+}
+
+// UnwrapOrElse returns the value of an Ok result, or computes a fallback from
+// the error of an Err result. It is the lazy variant of Result.UnwrapOr.
+func UnwrapOrElse[T any](r Result[T], f func(error) T) T {
+	if r.IsErr() {
+		return f(r.err)
+	}
+	return r.value
+}
+
+// Inspect calls f with the value of an Ok result, e.g. for logging, and returns
+// r unchanged.
+func Inspect[T any](r Result[T], f func(T)) Result[T] {
+	if r.IsOk() {
+		f(r.value)
+	}
+	return r
+}
+
+// InspectErr calls f with the error of an Err result and returns r unchanged.
+func InspectErr[T any](r Result[T], f func(error)) Result[T] {
+	if r.IsErr() {
+		f(r.err)
+	}
+	return r
+}
+
+// Zip combines two results into a result of a Pair. It is Ok only if both
+// results are Ok; otherwise it carries the first error, checking a before b.
+func Zip[A, B any](a Result[A], b Result[B]) Result[Pair[A, B]] {
+	if a.IsErr() {
+		return Err[Pair[A, B]](a.err)
+	}
+	if b.IsErr() {
+		return Err[Pair[A, B]](b.err)
+	}
+	return Ok(NewPair(a.value, b.value))
+}
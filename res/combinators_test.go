@@ -0,0 +1,79 @@
+package res_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestCombinators(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	t.Run("Map", func(t *testing.T) {
+		assert.Equal(t, "42", res.Map(res.Ok(42), strconv.Itoa).Unwrap())
+		assert.Equal(t, errBoom, res.Map(res.Err[int](errBoom), strconv.Itoa).Err())
+	})
+
+	t.Run("FlatMap and AndThen", func(t *testing.T) {
+		parse := func(s string) res.Result[int] { return res.Of(strconv.Atoi(s)) }
+
+		assert.Equal(t, 12, res.FlatMap(res.Ok("12"), parse).Unwrap())
+		assert.True(t, res.AndThen(res.Ok("x"), parse).IsErr())
+
+		called := false
+		r := res.AndThen(res.Err[string](errBoom), func(string) res.Result[int] {
+			called = true
+			return res.Ok(0)
+		})
+		assert.Equal(t, errBoom, r.Err())
+		assert.False(t, called)
+	})
+
+	t.Run("MapErr", func(t *testing.T) {
+		wrap := func(err error) error { return fmt.Errorf("context: %w", err) }
+
+		r := res.MapErr(res.Err[int](errBoom), wrap)
+		assert.ErrorIs(t, r.Err(), errBoom)
+		assert.EqualError(t, r.Err(), "context: boom")
+		assert.Equal(t, 1, res.MapErr(res.Ok(1), wrap).Unwrap())
+	})
+
+	t.Run("OrElse", func(t *testing.T) {
+		retry := func(error) res.Result[int] { return res.Ok(-1) }
+
+		assert.Equal(t, -1, res.OrElse(res.Err[int](errBoom), retry).Unwrap())
+		assert.Equal(t, 1, res.OrElse(res.Ok(1), retry).Unwrap())
+	})
+
+	t.Run("UnwrapOrElse", func(t *testing.T) {
+		fallback := func(err error) string { return "fallback: " + err.Error() }
+
+		assert.Equal(t, "fallback: boom", res.UnwrapOrElse(res.Err[string](errBoom), fallback))
+		assert.Equal(t, "value", res.UnwrapOrElse(res.Ok("value"), fallback))
+	})
+
+	t.Run("Inspect", func(t *testing.T) {
+		var seen []string
+		res.Inspect(res.Ok("a"), func(s string) { seen = append(seen, s) })
+		res.Inspect(res.Err[string](errBoom), func(s string) { seen = append(seen, s) })
+		res.InspectErr(res.Err[string](errBoom), func(err error) { seen = append(seen, err.Error()) })
+		res.InspectErr(res.Ok("b"), func(err error) { seen = append(seen, err.Error()) })
+
+		assert.Equal(t, []string{"a", "boom"}, seen)
+	})
+
+	t.Run("Zip", func(t *testing.T) {
+		a, b := res.Zip(res.Ok(1), res.Ok("one")).Unwrap().Values()
+		assert.Equal(t, 1, a)
+		assert.Equal(t, "one", b)
+
+		errOther := errors.New("other")
+		assert.Equal(t, errBoom, res.Zip(res.Err[int](errBoom), res.Err[string](errOther)).Err())
+		assert.Equal(t, errOther, res.Zip(res.Ok(1), res.Err[string](errOther)).Err())
+	})
+}
@@ -0,0 +1,90 @@
+package res
+
+import (
+	"cmp"
+	"reflect"
+)
+
+// EqualPair reports whether two pairs hold equal values.
+func EqualPair[A, B comparable](x, y Pair[A, B]) bool {
+	return x.first == y.first && x.second == y.second
+}
+
+// ComparePair compares two pairs lexicographically: first by their first
+// values, then by their second values, using cmp.Compare. It returns -1, 0 or
+// +1, and can be passed to slices.SortFunc:
+//
+//	slices.SortFunc(pairs, res.ComparePair[string, int])
+func ComparePair[A, B cmp.Ordered](x, y Pair[A, B]) int {
+	if c := cmp.Compare(x.first, y.first); c != 0 {
+		return c
+	}
+	return cmp.Compare(x.second, y.second)
+}
+
+// ComparePairFunc is like ComparePair, but uses the given functions to compare
+// the values, for types that are not cmp.Ordered.
+func ComparePairFunc[A, B any](x, y Pair[A, B], cmpA func(A, A) int, cmpB func(B, B) int) int {
+	if c := cmpA(x.first, y.first); c != 0 {
+		return c
+	}
+	return cmpB(x.second, y.second)
+}
+
+// EqualResult reports whether two results are equal: both Ok with equal
+// values, or both Err with equal errors. Errors are compared with ==, or with
+// reflect.DeepEqual if they are not comparable, so that EqualResult never
+// panics. To compare results decoded from JSON, use EqualResultByMessage.
+func EqualResult[T comparable](x, y Result[T]) bool {
+	switch {
+	case x.IsOk() && y.IsOk():
+		return x.value == y.value
+	case x.IsErr() && y.IsErr():
+		return equalErr(x.err, y.err)
+	default:
+		return false
+	}
+}
+
+// EqualResultByMessage is like EqualResult, but treats two errors as equal if
+// they have the same message. JSON only preserves the message of an error, so
+// this lets results decoded from JSON compare equal to their originals.
+func EqualResultByMessage[T comparable](x, y Result[T]) bool {
+	switch {
+	case x.IsOk() && y.IsOk():
+		return x.value == y.value
+	case x.IsErr() && y.IsErr():
+		return x.err.Error() == y.err.Error()
+	default:
+		return false
+	}
+}
+
+// equalErr reports whether two errors are equal without panicking on errors
+// whose dynamic type is not comparable.
+func equalErr(x, y error) bool {
+	vx, vy := reflect.ValueOf(x), reflect.ValueOf(y)
+	if vx.Type() != vy.Type() {
+		return false
+	}
+	if vx.Comparable() && vy.Comparable() {
+		return x == y
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// CompareResult compares two results. Every Ok sorts before every Err; Ok
+// results are ordered by value and Err results by error message. It returns
+// -1, 0 or +1.
+func CompareResult[T cmp.Ordered](x, y Result[T]) int {
+	switch {
+	case x.IsOk() && y.IsOk():
+		return cmp.Compare(x.value, y.value)
+	case x.IsOk():
+		return -1
+	case y.IsOk():
+		return +1
+	default:
+		return cmp.Compare(x.err.Error(), y.err.Error())
+	}
+}
@@ -0,0 +1,66 @@
+package res_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestComparePair(t *testing.T) {
+	assert.True(t, res.EqualPair(res.NewPair("a", 1), res.NewPair("a", 1)))
+	assert.False(t, res.EqualPair(res.NewPair("a", 1), res.NewPair("a", 2)))
+
+	pairs := []res.Pair[string, int]{
+		res.NewPair("b", 1),
+		res.NewPair("a", 2),
+		res.NewPair("a", 1),
+	}
+	slices.SortFunc(pairs, res.ComparePair[string, int])
+	assert.Equal(t, []res.Pair[string, int]{
+		res.NewPair("a", 1),
+		res.NewPair("a", 2),
+		res.NewPair("b", 1),
+	}, pairs)
+
+	byLength := func(x, y []int) int { return len(x) - len(y) }
+	assert.Negative(t, res.ComparePairFunc(res.NewPair("a", []int{1}), res.NewPair("a", []int{1, 2}), strings.Compare, byLength))
+}
+
+// listError is an error type that is not comparable.
+type listError []string
+
+func (e listError) Error() string { return strings.Join(e, ", ") }
+
+func TestCompareResult(t *testing.T) {
+	errA := errors.New("a")
+
+	assert.True(t, res.EqualResult(res.Ok(1), res.Ok(1)))
+	assert.False(t, res.EqualResult(res.Ok(1), res.Ok(2)))
+	assert.False(t, res.EqualResult(res.Ok(1), res.Err[int](errA)))
+	assert.True(t, res.EqualResult(res.Err[int](errA), res.Err[int](errA)))
+
+	data, _ := json.Marshal(res.Err[int](errA))
+	var decoded res.Result[int]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.False(t, res.EqualResult(res.Err[int](errA), decoded))
+	assert.True(t, res.EqualResultByMessage(res.Err[int](errA), decoded))
+	assert.False(t, res.EqualResultByMessage(res.Err[int](errA), res.Ok(1)))
+
+	// Errors whose dynamic type is not comparable must not panic.
+	assert.True(t, res.EqualResult(res.Err[int](listError{"a", "b"}), res.Err[int](listError{"a", "b"})))
+	assert.False(t, res.EqualResult(res.Err[int](listError{"a"}), res.Err[int](listError{"b"})))
+	assert.False(t, res.EqualResult(res.Err[int](listError{"a"}), res.Err[int](errA)))
+	wrapped := fmt.Errorf("wrap: %w", listError{"a"})
+	assert.True(t, res.EqualResult(res.Err[int](wrapped), res.Err[int](wrapped)))
+
+	rs := []res.Result[int]{res.Err[int](errors.New("b")), res.Ok(2), res.Err[int](errA), res.Ok(1)}
+	slices.SortFunc(rs, res.CompareResult[int])
+	assert.Equal(t, "[Ok(1) Ok(2) Err(a) Err(b)]", fmt.Sprint(rs))
+}
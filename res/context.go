@@ -0,0 +1,83 @@
+package res
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// ContextError wraps an error with a message describing what was being done
+// when it occurred, and the frame of the code that added the context. It is
+// created by Result.Context and Result.WithContextf and keeps errors.Is and
+// errors.As working on the wrapped error.
+//
+// Formatting a ContextError with %+v prints the whole context chain, one
+// message per line followed by the location that added it, and the root error
+// last.
+type ContextError struct {
+	// Msg is the context message.
+	Msg string
+	// Frame is the caller that added the context.
+	Frame runtime.Frame
+	// Err is the wrapped error.
+	Err error
+}
+
+// Error implements the standard error interface. It returns the context
+// message followed by the message of the wrapped error.
+func (e *ContextError) Error() string {
+	return e.Msg + ": " + e.Err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *ContextError) Unwrap() error {
+	return e.Err
+}
+
+// Format implements fmt.Formatter. The %+v verb prints the context chain with
+// locations; every other verb prints the same as Error.
+func (e *ContextError) Format(s fmt.State, verb rune) {
+	if verb != 'v' || !s.Flag('+') {
+		_, _ = io.WriteString(s, e.Error())
+		return
+	}
+
+	var err error = e
+	for err != nil {
+		ce, ok := err.(*ContextError)
+		if !ok {
+			_, _ = io.WriteString(s, err.Error())
+			return
+		}
+		_, _ = fmt.Fprintf(s, "%s\n    at %s (%s:%d)\n", ce.Msg, ce.Frame.Function, ce.Frame.File, ce.Frame.Line)
+		err = ce.Err
+	}
+}
+
+// Context wraps the error of an Err result with msg and the location of the
+// caller. An Ok result is returned unchanged.
+func (r Result[T]) Context(msg string) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Err[T](newContextError(msg, r.err))
+}
+
+// WithContextf is like Context, but formats the message according to a format
+// specifier. The arguments are only formatted if the result is an Err.
+func (r Result[T]) WithContextf(format string, args ...any) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Err[T](newContextError(fmt.Sprintf(format, args...), r.err))
+}
+
+// newContextError creates a ContextError, capturing the frame of the caller of
+// the exported function that called it.
+func newContextError(msg string, err error) *ContextError {
+	var pcs [1]uintptr
+	// Skip runtime.Callers, newContextError and the Result method.
+	runtime.Callers(3, pcs[:])
+	frame, _ := runtime.CallersFrames(pcs[:]).Next()
+	return &ContextError{Msg: msg, Frame: frame, Err: err}
+}
@@ -0,0 +1,49 @@
+package res_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestContext(t *testing.T) {
+	t.Run("Ok is unchanged", func(t *testing.T) {
+		assert.Equal(t, res.Ok(1), res.Ok(1).Context("ignored").WithContextf("ignored %d", 1))
+	})
+
+	t.Run("Wraps the error", func(t *testing.T) {
+		r := res.Err[int](fs.ErrNotExist).
+			Context("reading config").
+			WithContextf("loading service %q", "api")
+
+		err := r.Err()
+		assert.EqualError(t, err, `loading service "api": reading config: file does not exist`)
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+
+		var ce *res.ContextError
+		assert.ErrorAs(t, err, &ce)
+		assert.Equal(t, `loading service "api"`, ce.Msg)
+		assert.True(t, strings.HasSuffix(ce.Frame.File, "context_test.go"))
+		assert.True(t, strings.HasSuffix(ce.Frame.Function, "TestContext.func2"))
+	})
+
+	t.Run("Formats the chain", func(t *testing.T) {
+		err := res.Err[int](errors.New("root cause")).Context("inner").Context("outer").Err()
+
+		assert.Equal(t, "outer: inner: root cause", fmt.Sprintf("%v", err))
+
+		chain := fmt.Sprintf("%+v", err)
+		lines := strings.Split(chain, "\n")
+		assert.Len(t, lines, 5)
+		assert.Equal(t, "outer", lines[0])
+		assert.Contains(t, lines[1], "context_test.go")
+		assert.Equal(t, "inner", lines[2])
+		assert.Equal(t, "root cause", lines[4])
+	})
+}
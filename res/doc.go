@@ -59,11 +59,10 @@ Consider a sequence of operations:
 	// Now, chain these operations together.
 	config := map[string]string{"filename": "data.txt"}
 
-	// The `AndThen` method chains functions that return a Result.
+	// The `AndThen` function chains functions that return a Result.
 	// The chain stops at the first `Err`.
-	finalResult := getFilename(config).
-		AndThen(readContent).
-		AndThen(parseNumber)
+	content := res.AndThen(getFilename(config), readContent)
+	finalResult := res.AndThen(content, parseNumber)
 
 	// Safely handle the outcome.
 	if finalResult.IsErr() {
@@ -72,5 +71,40 @@ Consider a sequence of operations:
 		// No error occurred, we can safely get the value.
 		fmt.Printf("Pipeline succeeded, result: %d\n", finalResult.Unwrap())
 	}
+
+# Combinators
+
+Because Go methods cannot introduce new type parameters, the combinators that
+change the value type are top-level functions: `Map`, `FlatMap` (and its alias
+`AndThen`), `MapErr`, `OrElse`, `UnwrapOrElse`, `Inspect`, `InspectErr` and
+`Zip`.
+
+# Pairs and Tuples
+
+`Pair`, `Triple` and `Quad` group values of different types. Maps can be turned
+into sorted slices of pairs with `ToPairsByKey` or `ToPairsByValue`, and back
+with `FromPairs`, which takes a resolver for duplicate keys. `GroupBy` groups a
+slice into pairs of key and items, preserving the order of first appearance:
+
+	byInitial := res.GroupBy(words, func(w string) byte { return w[0] })
+	for _, g := range byInitial {
+		initial, words := g.Values()
+		fmt.Println(string(initial), words)
+	}
+
+# Panics and Early Returns
+
+`Try` and `TryE` run a function and capture a panic as an Err holding a
+`*PanicError` with the stack trace. Panics recovered by the promise package
+match `*PanicError` too. `Propagate` is a variant of `Unwrap` whose
+panic is meant to be recovered by `Try`, `TryE` or a deferred `Handle`, which
+gives an early-return pattern for code that unwraps many results:
+
+	func sum(a, b string) (n int, err error) {
+		defer res.Handle(&err)
+		x := res.Of(strconv.Atoi(a)).Propagate()
+		y := res.Of(strconv.Atoi(b)).Propagate()
+		return x + y, nil
+	}
 */
 package res
@@ -0,0 +1,161 @@
+package res
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Either represents a value that is one of two valid alternatives: a Left of
+// type L or a Right of type R. Unlike Result, neither side has to be an error,
+// which makes Either suitable for APIs that return one of two valid shapes.
+// By convention, when one side denotes failure it is the Left side.
+//
+// The zero value of Either is a Left holding the zero value of L.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left creates an Either holding a Left value.
+func Left[L, R any](value L) Either[L, R] {
+	return Either[L, R]{left: value}
+}
+
+// Right creates an Either holding a Right value.
+func Right[L, R any](value R) Either[L, R] {
+	return Either[L, R]{right: value, isRight: true}
+}
+
+// IsLeft returns true if the Either holds a Left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight returns true if the Either holds a Right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// Left returns the Left value and a boolean indicating whether the Either
+// holds one.
+func (e Either[L, R]) Left() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// Right returns the Right value and a boolean indicating whether the Either
+// holds one.
+func (e Either[L, R]) Right() (R, bool) {
+	return e.right, e.isRight
+}
+
+// Swap returns a new Either with the sides exchanged.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+// Either converts the result into an Either: an Err becomes a Left holding the
+// error and an Ok becomes a Right holding the value.
+func (r Result[T]) Either() Either[error, T] {
+	if r.IsErr() {
+		return Left[error, T](r.err)
+	}
+	return Right[error](r.value)
+}
+
+// ErrNilLeft is the error of the Result that FromEither returns for a Left
+// holding a nil error.
+var ErrNilLeft = errors.New("res: Either is Left with a nil error")
+
+// FromEither converts an Either whose Left side is an error into a Result. It
+// is the inverse of Result.Either. A Left holding a nil error still becomes an
+// Err, with ErrNilLeft, rather than an Ok with the zero value.
+func FromEither[T any](e Either[error, T]) Result[T] {
+	if e.isRight {
+		return Ok(e.right)
+	}
+	if e.left == nil {
+		return Err[T](ErrNilLeft)
+	}
+	return Err[T](e.left)
+}
+
+// Fold reduces an Either to a single value by applying onLeft or onRight,
+// depending on the side it holds.
+func Fold[L, R, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// MapLeft transforms the Left value with f. A Right is returned unchanged.
+func MapLeft[L, R, L2 any](e Either[L, R], f func(L) L2) Either[L2, R] {
+	if e.isRight {
+		return Right[L2](e.right)
+	}
+	return Left[L2, R](f(e.left))
+}
+
+// MapRight transforms the Right value with f. A Left is returned unchanged.
+func MapRight[L, R, R2 any](e Either[L, R], f func(R) R2) Either[L, R2] {
+	if e.isRight {
+		return Right[L](f(e.right))
+	}
+	return Left[L, R2](e.left)
+}
+
+// eitherJSON is the wire form of an Either. Exactly one of the fields is set.
+type eitherJSON struct {
+	Left  json.RawMessage `json:"left,omitempty"`
+	Right json.RawMessage `json:"right,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. The Either is encoded as an object
+// tagged with its active side: {"left": value} or {"right": value}.
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	var raw eitherJSON
+	var err error
+	if e.isRight {
+		raw.Right, err = json.Marshal(e.right)
+	} else {
+		raw.Left, err = json.Marshal(e.left)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the forms produced by
+// MarshalJSON and rejects objects with both or neither of "left" and "right".
+// A JSON null leaves e unchanged, as is conventional for encoding/json.
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	var raw eitherJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("res: decoding Either: %w", err)
+	}
+	switch {
+	case raw.Left != nil && raw.Right != nil:
+		return errors.New(`res: decoding Either: both "left" and "right" are set`)
+	case raw.Left != nil:
+		var value L
+		if err := json.Unmarshal(raw.Left, &value); err != nil {
+			return fmt.Errorf("res: decoding Either: %w", err)
+		}
+		*e = Left[L, R](value)
+	case raw.Right != nil:
+		var value R
+		if err := json.Unmarshal(raw.Right, &value); err != nil {
+			return fmt.Errorf("res: decoding Either: %w", err)
+		}
+		*e = Right[L](value)
+	default:
+		return errors.New(`res: decoding Either: neither "left" nor "right" is set`)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package res_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestEither(t *testing.T) {
+	t.Run("Left and Right", func(t *testing.T) {
+		l := res.Left[int, string](1)
+		assert.True(t, l.IsLeft())
+		assert.False(t, l.IsRight())
+		v, ok := l.Left()
+		assert.True(t, ok)
+		assert.Equal(t, 1, v)
+		_, ok = l.Right()
+		assert.False(t, ok)
+
+		r := res.Right[int]("one")
+		assert.True(t, r.IsRight())
+		s, ok := r.Right()
+		assert.True(t, ok)
+		assert.Equal(t, "one", s)
+
+		var zero res.Either[int, string]
+		assert.True(t, zero.IsLeft())
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		swapped := res.Left[int, string](1).Swap()
+		assert.True(t, swapped.IsRight())
+		v, _ := swapped.Right()
+		assert.Equal(t, 1, v)
+	})
+
+	t.Run("Fold and Map", func(t *testing.T) {
+		describe := func(e res.Either[int, string]) string {
+			return res.Fold(e, strconv.Itoa, func(s string) string { return "s:" + s })
+		}
+		assert.Equal(t, "1", describe(res.Left[int, string](1)))
+		assert.Equal(t, "s:x", describe(res.Right[int]("x")))
+
+		ml := res.MapLeft(res.Left[int, string](2), func(n int) float64 { return float64(n) / 4 })
+		v, _ := ml.Left()
+		assert.Equal(t, 0.5, v)
+		assert.True(t, res.MapLeft(res.Right[int]("x"), strconv.Itoa).IsRight())
+
+		mr := res.MapRight(res.Right[int]("abc"), func(s string) int { return len(s) })
+		n, _ := mr.Right()
+		assert.Equal(t, 3, n)
+		assert.True(t, res.MapRight(res.Left[int, string](1), func(s string) int { return len(s) }).IsLeft())
+	})
+
+	t.Run("Result conversions", func(t *testing.T) {
+		errBoom := errors.New("boom")
+
+		e := res.Ok(1).Either()
+		assert.True(t, e.IsRight())
+		assert.Equal(t, 1, res.FromEither(e).Unwrap())
+
+		e = res.Err[int](errBoom).Either()
+		assert.True(t, e.IsLeft())
+		assert.Equal(t, errBoom, res.FromEither(e).Err())
+
+		assert.ErrorIs(t, res.FromEither(res.Left[error, int](nil)).Err(), res.ErrNilLeft)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type Shape = res.Either[[]int, map[string]int]
+
+		data, err := json.Marshal(res.Left[[]int, map[string]int]([]int{1, 2}))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"left":[1,2]}`, string(data))
+
+		data, err = json.Marshal(res.Right[[]int](map[string]int{"a": 1}))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"right":{"a":1}}`, string(data))
+
+		var shape Shape
+		assert.NoError(t, json.Unmarshal(data, &shape))
+		m, ok := shape.Right()
+		assert.True(t, ok)
+		assert.Equal(t, map[string]int{"a": 1}, m)
+
+		assert.Error(t, json.Unmarshal([]byte(`{}`), &shape))
+		assert.Error(t, json.Unmarshal([]byte(`{"left":[],"right":{}}`), &shape))
+		assert.Error(t, json.Unmarshal([]byte(`{"left":"x"}`), &shape))
+
+		assert.NoError(t, json.Unmarshal([]byte(`null`), &shape))
+		m, ok = shape.Right()
+		assert.True(t, ok)
+		assert.Equal(t, map[string]int{"a": 1}, m)
+	})
+}
@@ -0,0 +1,69 @@
+package res
+
+import (
+	"fmt"
+	"io"
+)
+
+// writeWrapped writes name, followed by vals formatted with the directive of
+// s and verb, separated by commas and enclosed in parentheses.
+func writeWrapped(s fmt.State, verb rune, name string, vals ...any) {
+	directive := fmt.FormatString(s, verb)
+	_, _ = io.WriteString(s, name+"(")
+	for i, v := range vals {
+		if i > 0 {
+			_, _ = io.WriteString(s, ", ")
+		}
+		_, _ = fmt.Fprintf(s, directive, v)
+	}
+	_, _ = io.WriteString(s, ")")
+}
+
+// Format implements fmt.Formatter. A result is printed as Ok(value) or
+// Err(error), with the verb and flags applied to the value or error, so that
+// for instance %+v prints the context chain of a ContextError.
+func (r Result[T]) Format(s fmt.State, verb rune) {
+	if r.IsErr() {
+		writeWrapped(s, verb, "Err", r.err)
+		return
+	}
+	writeWrapped(s, verb, "Ok", r.value)
+}
+
+// String returns the result formatted with %v, e.g. Ok(42) or Err(boom).
+func (r Result[T]) String() string {
+	return fmt.Sprint(r)
+}
+
+// Format implements fmt.Formatter. A pair is printed as (first, second), with
+// the verb and flags applied to each value.
+func (p Pair[A, B]) Format(s fmt.State, verb rune) {
+	writeWrapped(s, verb, "", p.first, p.second)
+}
+
+// String returns the pair formatted with %v, e.g. (a, 1).
+func (p Pair[A, B]) String() string {
+	return fmt.Sprint(p)
+}
+
+// Format implements fmt.Formatter. A triple is printed as (first, second,
+// third), with the verb and flags applied to each value.
+func (t Triple[A, B, C]) Format(s fmt.State, verb rune) {
+	writeWrapped(s, verb, "", t.first, t.second, t.third)
+}
+
+// String returns the triple formatted with %v, e.g. (a, 1, true).
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprint(t)
+}
+
+// Format implements fmt.Formatter. A quad is printed as (first, second, third,
+// fourth), with the verb and flags applied to each value.
+func (q Quad[A, B, C, D]) Format(s fmt.State, verb rune) {
+	writeWrapped(s, verb, "", q.first, q.second, q.third, q.fourth)
+}
+
+// String returns the quad formatted with %v, e.g. (a, 1, true, 2.5).
+func (q Quad[A, B, C, D]) String() string {
+	return fmt.Sprint(q)
+}
@@ -0,0 +1,35 @@
+package res_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestFormat(t *testing.T) {
+	t.Run("Result", func(t *testing.T) {
+		assert.Equal(t, "Ok(42)", fmt.Sprintf("%v", res.Ok(42)))
+		assert.Equal(t, "Err(boom)", res.Err[int](errors.New("boom")).String())
+		assert.Equal(t, `Ok("hi")`, fmt.Sprintf("%q", res.Ok("hi")))
+		assert.Equal(t, "Ok(  7)", fmt.Sprintf("%3d", res.Ok(7)))
+		assert.Equal(t, "Ok({Name:x})", fmt.Sprintf("%+v", res.Ok(struct{ Name string }{"x"})))
+	})
+
+	t.Run("Result with context chain", func(t *testing.T) {
+		r := res.Err[int](errors.New("root")).Context("outer")
+		assert.Equal(t, "Err(outer: root)", r.String())
+		assert.True(t, strings.Contains(fmt.Sprintf("%+v", r), "format_test.go"))
+	})
+
+	t.Run("Tuples", func(t *testing.T) {
+		assert.Equal(t, "(a, 1)", res.NewPair("a", 1).String())
+		assert.Equal(t, `("a", "b")`, fmt.Sprintf("%q", res.NewPair("a", "b")))
+		assert.Equal(t, "(a, 1, true)", res.NewTriple("a", 1, true).String())
+		assert.Equal(t, "(a, 1, true, 2.5)", fmt.Sprint(res.NewQuad("a", 1, true, 2.5)))
+	})
+}
@@ -0,0 +1,156 @@
+package res
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// resultJSON is the wire form of a Result. Exactly one of the fields is set.
+type resultJSON struct {
+	Ok  json.RawMessage `json:"ok,omitempty"`
+	Err *string         `json:"err,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. An Ok result is encoded as
+// {"ok": value} and an Err result as {"err": "message"}.
+//
+// Only the message of the error survives the round trip: an Err result decoded
+// by UnmarshalJSON holds a plain error with the same message, so errors.Is and
+// errors.As no longer match the original error.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.IsErr() {
+		msg := r.err.Error()
+		return json.Marshal(resultJSON{Err: &msg})
+	}
+	value, err := json.Marshal(r.value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(resultJSON{Ok: value})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It accepts the forms produced by
+// MarshalJSON and rejects objects with both or neither of "ok" and "err". A
+// JSON null leaves r unchanged, as is conventional for encoding/json.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	var raw resultJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("res: decoding Result: %w", err)
+	}
+	switch {
+	case raw.Err != nil && raw.Ok != nil:
+		return errors.New(`res: decoding Result: both "ok" and "err" are set`)
+	case raw.Err != nil:
+		*r = Err[T](errors.New(*raw.Err))
+	case raw.Ok != nil:
+		var value T
+		if err := json.Unmarshal(raw.Ok, &value); err != nil {
+			return fmt.Errorf("res: decoding Result: %w", err)
+		}
+		*r = Ok(value)
+	default:
+		return errors.New(`res: decoding Result: neither "ok" nor "err" is set`)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. A pair is encoded as a two-element
+// array, [first, second].
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]any{p.first, p.second})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It expects a two-element array.
+// A JSON null leaves p unchanged, as is conventional for encoding/json.
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	var decoded Pair[A, B]
+	if err := unmarshalTuple(data, "Pair", &decoded.first, &decoded.second); err != nil {
+		return err
+	}
+	*p = decoded
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler using the JSON form of the
+// pair. Among other things, this allows pairs to be used as keys of maps that
+// are encoded to JSON.
+func (p Pair[A, B]) MarshalText() ([]byte, error) {
+	return p.MarshalJSON()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the form
+// produced by MarshalText.
+func (p *Pair[A, B]) UnmarshalText(text []byte) error {
+	return p.UnmarshalJSON(text)
+}
+
+// MarshalJSON implements json.Marshaler. A triple is encoded as a
+// three-element array.
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]any{t.first, t.second, t.third})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It expects a three-element array.
+// A JSON null leaves t unchanged.
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	var decoded Triple[A, B, C]
+	if err := unmarshalTuple(data, "Triple", &decoded.first, &decoded.second, &decoded.third); err != nil {
+		return err
+	}
+	*t = decoded
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. A quad is encoded as a four-element
+// array.
+func (q Quad[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]any{q.first, q.second, q.third, q.fourth})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It expects a four-element array.
+// A JSON null leaves q unchanged.
+func (q *Quad[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	if isNull(data) {
+		return nil
+	}
+	var decoded Quad[A, B, C, D]
+	if err := unmarshalTuple(data, "Quad", &decoded.first, &decoded.second, &decoded.third, &decoded.fourth); err != nil {
+		return err
+	}
+	*q = decoded
+	return nil
+}
+
+// unmarshalTuple decodes a JSON array into the given element pointers. The
+// array must have exactly as many elements as there are pointers.
+func unmarshalTuple(data []byte, name string, elems ...any) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("res: decoding %s: %w", name, err)
+	}
+	if len(raw) != len(elems) {
+		return fmt.Errorf("res: decoding %s: expected %d elements, got %d", name, len(elems), len(raw))
+	}
+	for i, elem := range elems {
+		if err := json.Unmarshal(raw[i], elem); err != nil {
+			return fmt.Errorf("res: decoding %s: element %d: %w", name, i, err)
+		}
+	}
+	return nil
+}
+
+// isNull reports whether data is the JSON literal null.
+func isNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
@@ -0,0 +1,94 @@
+package res_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestResultJSON(t *testing.T) {
+	t.Run("Marshal", func(t *testing.T) {
+		data, err := json.Marshal(res.Ok(42))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ok":42}`, string(data))
+
+		data, err = json.Marshal(res.Err[int](errors.New("boom")))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"err":"boom"}`, string(data))
+
+		data, err = json.Marshal(res.Ok[*int](nil))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ok":null}`, string(data))
+	})
+
+	t.Run("Round trip", func(t *testing.T) {
+		in := []res.Result[string]{res.Ok("a"), res.Err[string](errors.New("b"))}
+		data, err := json.Marshal(in)
+		assert.NoError(t, err)
+
+		var out []res.Result[string]
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, "a", out[0].Unwrap())
+		assert.EqualError(t, out[1].Err(), "b")
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		var r res.Result[int]
+		assert.Error(t, json.Unmarshal([]byte(`{}`), &r))
+		assert.Error(t, json.Unmarshal([]byte(`{"ok":1,"err":"x"}`), &r))
+		assert.Error(t, json.Unmarshal([]byte(`{"ok":"x"}`), &r))
+		assert.Error(t, json.Unmarshal([]byte(`[]`), &r))
+	})
+
+	t.Run("Null is a no-op", func(t *testing.T) {
+		var v struct {
+			R res.Result[int] `json:"r"`
+		}
+		v.R = res.Ok(1)
+		assert.NoError(t, json.Unmarshal([]byte(`{"r":null}`), &v))
+		assert.Equal(t, 1, v.R.Unwrap())
+	})
+}
+
+func TestPairJSON(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		data, err := json.Marshal(res.NewPair("a", 1))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `["a",1]`, string(data))
+
+		var p res.Pair[string, int]
+		assert.NoError(t, json.Unmarshal(data, &p))
+		assert.Equal(t, res.NewPair("a", 1), p)
+	})
+
+	t.Run("Map keys use the text form", func(t *testing.T) {
+		in := map[res.Pair[string, int]]bool{res.NewPair("x", 2): true}
+		data, err := json.Marshal(in)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"[\"x\",2]":true}`, string(data))
+
+		var out map[res.Pair[string, int]]bool
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("Invalid input", func(t *testing.T) {
+		var p res.Pair[string, int]
+		assert.Error(t, json.Unmarshal([]byte(`["a"]`), &p))
+		assert.Error(t, json.Unmarshal([]byte(`["a","b"]`), &p))
+		assert.Error(t, json.Unmarshal([]byte(`{}`), &p))
+	})
+
+	t.Run("Null is a no-op", func(t *testing.T) {
+		var v struct {
+			P res.Pair[string, int] `json:"p"`
+		}
+		v.P = res.NewPair("a", 1)
+		assert.NoError(t, json.Unmarshal([]byte(`{"p":null}`), &v))
+		assert.Equal(t, res.NewPair("a", 1), v.P)
+	})
+}
@@ -0,0 +1,165 @@
+package res
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/goexts/generic/ptr"
+)
+
+// Option represents an optional value: either Some value of type T, or None.
+// It makes absence explicit, instead of overloading nil pointers or zero values
+// to mean "not set".
+//
+// The zero value of Option is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some creates an Option holding the given value.
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+// None creates an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPtr converts a pointer into an Option: a nil pointer becomes None, any
+// other pointer becomes Some of the value it points to.
+func FromPtr[T any](v *T) Option[T] {
+	if v == nil {
+		return None[T]()
+	}
+	return Some(ptr.Val(v))
+}
+
+// IsSome returns true if the option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns true if the option is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the contained value and a boolean indicating whether there was
+// one. This provides a safe, idiomatic Go way to access the value.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the contained value. It panics if the option is None.
+// See also: Expect, UnwrapOr.
+func (o Option[T]) Unwrap() T {
+	if !o.ok {
+		panic("called `Option.Unwrap()` on a `None` value")
+	}
+	return o.value
+}
+
+// Expect returns the contained value. It panics with a custom message if the
+// option is None.
+func (o Option[T]) Expect(message string) T {
+	if !o.ok {
+		panic(message)
+	}
+	return o.value
+}
+
+// UnwrapOr returns the contained value or a provided default value.
+func (o Option[T]) UnwrapOr(defaultValue T) T {
+	if !o.ok {
+		return defaultValue
+	}
+	return o.value
+}
+
+// Filter returns o if it holds a value for which pred returns true, and None
+// otherwise.
+func (o Option[T]) Filter(pred func(T) bool) Option[T] {
+	if o.ok && pred(o.value) {
+		return o
+	}
+	return None[T]()
+}
+
+// OrElse returns o if it holds a value, and otherwise the option computed by f.
+func (o Option[T]) OrElse(f func() Option[T]) Option[T] {
+	if o.ok {
+		return o
+	}
+	return f()
+}
+
+// Ptr converts the option into a pointer: None becomes nil, Some becomes a
+// pointer to a copy of the value.
+func (o Option[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+	return ptr.Of(o.value)
+}
+
+// OkOr converts the option into a Result, using err for None.
+func (o Option[T]) OkOr(err error) Result[T] {
+	if !o.ok {
+		return Err[T](err)
+	}
+	return Ok(o.value)
+}
+
+// Option converts the result into an Option, discarding the error of an Err
+// result.
+func (r Result[T]) Option() Option[T] {
+	if r.IsErr() {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// MarshalJSON implements json.Marshaler. None is encoded as null and Some as
+// the encoding of its value.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null decodes to None and
+// any other value to Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("res: decoding Option: %w", err)
+	}
+	*o = Some(value)
+	return nil
+}
+
+// MapOption transforms the value of a Some option with f. None is returned
+// unchanged.
+func MapOption[T, U any](o Option[T], f func(T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(f(o.value))
+}
+
+// FlatMapOption chains a function that itself returns an Option onto a Some
+// option. None short-circuits and f is not called.
+func FlatMapOption[T, U any](o Option[T], f func(T) Option[U]) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return f(o.value)
+}
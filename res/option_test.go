@@ -0,0 +1,93 @@
+package res_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/ptr"
+	"github.com/goexts/generic/res"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("Some and None", func(t *testing.T) {
+		some := res.Some(42)
+		assert.True(t, some.IsSome())
+		assert.False(t, some.IsNone())
+		assert.Equal(t, 42, some.Unwrap())
+		assert.Equal(t, 42, some.Expect("must be set"))
+
+		none := res.None[int]()
+		assert.True(t, none.IsNone())
+		assert.Equal(t, 7, none.UnwrapOr(7))
+		assert.Panics(t, func() { none.Unwrap() })
+		assert.PanicsWithValue(t, "must be set", func() { none.Expect("must be set") })
+
+		var zero res.Option[int]
+		assert.True(t, zero.IsNone())
+
+		val, ok := some.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 42, val)
+	})
+
+	t.Run("Map, FlatMap, Filter and OrElse", func(t *testing.T) {
+		upper := res.MapOption(res.Some("go"), strings.ToUpper)
+		assert.Equal(t, "GO", upper.Unwrap())
+		assert.True(t, res.MapOption(res.None[string](), strings.ToUpper).IsNone())
+
+		half := func(n int) res.Option[int] {
+			if n%2 != 0 {
+				return res.None[int]()
+			}
+			return res.Some(n / 2)
+		}
+		assert.Equal(t, 2, res.FlatMapOption(res.Some(4), half).Unwrap())
+		assert.True(t, res.FlatMapOption(res.Some(3), half).IsNone())
+
+		positive := func(n int) bool { return n > 0 }
+		assert.True(t, res.Some(1).Filter(positive).IsSome())
+		assert.True(t, res.Some(-1).Filter(positive).IsNone())
+
+		fallback := func() res.Option[int] { return res.Some(0) }
+		assert.Equal(t, 0, res.None[int]().OrElse(fallback).Unwrap())
+		assert.Equal(t, 5, res.Some(5).OrElse(fallback).Unwrap())
+	})
+
+	t.Run("Pointers", func(t *testing.T) {
+		assert.True(t, res.FromPtr[int](nil).IsNone())
+		assert.Equal(t, 3, res.FromPtr(ptr.Of(3)).Unwrap())
+		assert.Nil(t, res.None[int]().Ptr())
+		assert.Equal(t, 3, ptr.Val(res.Some(3).Ptr()))
+	})
+
+	t.Run("Result conversions", func(t *testing.T) {
+		errMissing := errors.New("missing")
+		assert.Equal(t, 1, res.Some(1).OkOr(errMissing).Unwrap())
+		assert.Equal(t, errMissing, res.None[int]().OkOr(errMissing).Err())
+
+		assert.Equal(t, 1, res.Ok(1).Option().Unwrap())
+		assert.True(t, res.Err[int](errMissing).Option().IsNone())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type DTO struct {
+			Name res.Option[string] `json:"name"`
+			Age  res.Option[int]    `json:"age"`
+		}
+
+		data, err := json.Marshal(DTO{Name: res.Some("Alice")})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Alice","age":null}`, string(data))
+
+		var dto DTO
+		assert.NoError(t, json.Unmarshal([]byte(`{"name":null,"age":0}`), &dto))
+		assert.True(t, dto.Name.IsNone())
+		assert.Equal(t, 0, dto.Age.Unwrap())
+
+		assert.Error(t, json.Unmarshal([]byte(`{"age":"x"}`), &dto))
+	})
+}
@@ -0,0 +1,76 @@
+package res
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ToPairs converts a map into a slice of key-value pairs. The order of the
+// pairs is unspecified; see ToPairsByKey, ToPairsByValue and ToPairsFunc for
+// sorted variants.
+func ToPairs[M ~map[K]V, K comparable, V any](m M) []Pair[K, V] {
+	pairs := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		pairs = append(pairs, NewPair(k, v))
+	}
+	return pairs
+}
+
+// ToPairsByKey converts a map into a slice of key-value pairs sorted by key in
+// ascending order.
+func ToPairsByKey[M ~map[K]V, K cmp.Ordered, V any](m M) []Pair[K, V] {
+	return ToPairsFunc(m, func(x, y Pair[K, V]) int {
+		return cmp.Compare(x.first, y.first)
+	})
+}
+
+// ToPairsByValue converts a map into a slice of key-value pairs sorted by value
+// in ascending order. The order of pairs with equal values is unspecified.
+func ToPairsByValue[M ~map[K]V, K comparable, V cmp.Ordered](m M) []Pair[K, V] {
+	return ToPairsFunc(m, func(x, y Pair[K, V]) int {
+		return cmp.Compare(x.second, y.second)
+	})
+}
+
+// ToPairsFunc converts a map into a slice of key-value pairs sorted with the
+// given comparison function, as used by slices.SortFunc.
+func ToPairsFunc[M ~map[K]V, K comparable, V any](m M, compare func(x, y Pair[K, V]) int) []Pair[K, V] {
+	pairs := ToPairs(m)
+	slices.SortFunc(pairs, compare)
+	return pairs
+}
+
+// FromPairs builds a map from a slice of key-value pairs. When a key occurs
+// more than once, resolve is called with the key, the value already in the map
+// and the incoming value, and its result is stored. If resolve is nil, the last
+// value wins.
+func FromPairs[K comparable, V any](pairs []Pair[K, V], resolve func(key K, existing, incoming V) V) map[K]V {
+	m := make(map[K]V, len(pairs))
+	for _, p := range pairs {
+		if existing, ok := m[p.first]; ok && resolve != nil {
+			m[p.first] = resolve(p.first, existing, p.second)
+			continue
+		}
+		m[p.first] = p.second
+	}
+	return m
+}
+
+// GroupBy groups items by the key computed by key. It returns one pair per
+// distinct key, holding the key and the items that map to it. Both the groups
+// and the items within a group keep the order in which they were first seen.
+func GroupBy[T any, K comparable](items []T, key func(T) K) []Pair[K, []T] {
+	var groups []Pair[K, []T]
+	index := make(map[K]int)
+	for _, item := range items {
+		k := key(item)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, NewPair(k, []T(nil)))
+		}
+		groups[i].second = append(groups[i].second, item)
+	}
+	return groups
+}
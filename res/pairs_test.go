@@ -0,0 +1,59 @@
+package res_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestToPairs(t *testing.T) {
+	m := map[string]int{"b": 1, "c": 3, "a": 2}
+
+	assert.ElementsMatch(t, []res.Pair[string, int]{
+		res.NewPair("a", 2), res.NewPair("b", 1), res.NewPair("c", 3),
+	}, res.ToPairs(m))
+
+	assert.Equal(t, []res.Pair[string, int]{
+		res.NewPair("a", 2), res.NewPair("b", 1), res.NewPair("c", 3),
+	}, res.ToPairsByKey(m))
+
+	assert.Equal(t, []res.Pair[string, int]{
+		res.NewPair("b", 1), res.NewPair("a", 2), res.NewPair("c", 3),
+	}, res.ToPairsByValue(m))
+
+	desc := res.ToPairsFunc(m, func(x, y res.Pair[string, int]) int {
+		return -res.ComparePair(x, y)
+	})
+	assert.Equal(t, "c", desc[0].First())
+}
+
+func TestFromPairs(t *testing.T) {
+	pairs := []res.Pair[string, int]{
+		res.NewPair("a", 1),
+		res.NewPair("b", 2),
+		res.NewPair("a", 3),
+	}
+
+	assert.Equal(t, map[string]int{"a": 3, "b": 2}, res.FromPairs(pairs, nil))
+
+	sum := func(_ string, existing, incoming int) int { return existing + incoming }
+	assert.Equal(t, map[string]int{"a": 4, "b": 2}, res.FromPairs(pairs, sum))
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3},
+		res.FromPairs(res.ToPairs(map[string]int{"a": 1, "b": 2, "c": 3}), nil))
+}
+
+func TestGroupBy(t *testing.T) {
+	words := []string{"banana", "avocado", "blueberry", "cherry", "apple"}
+
+	groups := res.GroupBy(words, func(w string) byte { return w[0] })
+
+	assert.Equal(t, []res.Pair[byte, []string]{
+		res.NewPair(byte('b'), []string{"banana", "blueberry"}),
+		res.NewPair(byte('a'), []string{"avocado", "apple"}),
+		res.NewPair(byte('c'), []string{"cherry"}),
+	}, groups)
+	assert.Empty(t, res.GroupBy([]string(nil), func(w string) byte { return w[0] }))
+}
@@ -0,0 +1,126 @@
+package res
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error a Result holds when Try or TryE recovered a panic.
+// It keeps the recovered value and the stack trace of the panicking goroutine.
+// The promise package reports panics with a type that embeds it, so
+// errors.As with a *PanicError matches panics recovered by either package.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace captured at the point of recovery.
+	Stack []byte
+}
+
+// NewPanicError creates a PanicError for a recovered panic value. It must be
+// called from the deferred function that recovered the panic so that the
+// captured stack includes the panicking frames.
+func NewPanicError(value any) *PanicError {
+	return &PanicError{Value: value, Stack: debug.Stack()}
+}
+
+// Error implements the standard error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is itself an error, making PanicError
+// compatible with errors.Is and errors.As.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// PropagatedError is the value Result.Propagate panics with. It carries the
+// error of the Err result so that Try, TryE or Handle can turn the panic back
+// into an ordinary error further up the stack.
+type PropagatedError struct {
+	// Err is the error of the Err result.
+	Err error
+}
+
+// Error implements the standard error interface.
+func (e *PropagatedError) Error() string {
+	return fmt.Sprintf("propagated error: %v", e.Err)
+}
+
+// Unwrap returns the propagated error.
+func (e *PropagatedError) Unwrap() error {
+	return e.Err
+}
+
+// Propagate returns the contained Ok value. If the result is an Err, it panics
+// with a *PropagatedError carrying the error. Together with Try, TryE and
+// Handle this provides an early-return pattern similar to Rust's `?`
+// operator:
+//
+//	func load(path string) (cfg Config, err error) {
+//		defer res.Handle(&err)
+//		data := res.Of(os.ReadFile(path)).Propagate()
+//		return res.Of(parse(data)).Propagate(), nil
+//	}
+//
+// Unlike Unwrap, the panic is meant to be recovered; it must not cross API
+// boundaries.
+func (r Result[T]) Propagate() T {
+	if r.IsErr() {
+		panic(&PropagatedError{Err: r.err})
+	}
+	return r.value
+}
+
+// Try calls f and captures its outcome in a Result. If f panics, the Result is
+// an Err: a panic raised by Propagate yields the propagated error itself, and
+// any other panic yields a *PanicError.
+func Try[T any](f func() T) (r Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = Err[T](recovered(v))
+		}
+	}()
+	return Ok(f())
+}
+
+// TryE is like Try, but for functions that also return an error.
+func TryE[T any](f func() (T, error)) (r Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = Err[T](recovered(v))
+		}
+	}()
+	return Of(f())
+}
+
+// Handle recovers a panic raised by Propagate and stores the propagated error
+// in *errp. It must be deferred directly, in a function with a named error
+// result:
+//
+//	defer res.Handle(&err)
+//
+// Panics that were not raised by Propagate are not recovered; they continue
+// to unwind the stack.
+func Handle(errp *error) {
+	v := recover()
+	if v == nil {
+		return
+	}
+	if perr, ok := v.(*PropagatedError); ok {
+		*errp = perr.Err
+		return
+	}
+	panic(v)
+}
+
+// recovered converts a recovered panic value into an error.
+func recovered(v any) error {
+	if perr, ok := v.(*PropagatedError); ok {
+		return perr.Err
+	}
+	return NewPanicError(v)
+}
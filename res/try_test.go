@@ -0,0 +1,69 @@
+package res_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/res"
+)
+
+func TestTry(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	t.Run("Try", func(t *testing.T) {
+		assert.Equal(t, 1, res.Try(func() int { return 1 }).Unwrap())
+
+		r := res.Try(func() int { panic("bad") })
+		var perr *res.PanicError
+		assert.ErrorAs(t, r.Err(), &perr)
+		assert.Equal(t, "bad", perr.Value)
+		assert.True(t, strings.Contains(string(perr.Stack), "try_test.go"))
+
+		r = res.Try(func() int { panic(errBoom) })
+		assert.ErrorIs(t, r.Err(), errBoom)
+	})
+
+	t.Run("TryE", func(t *testing.T) {
+		assert.Equal(t, 2, res.TryE(func() (int, error) { return 2, nil }).Unwrap())
+		assert.Equal(t, errBoom, res.TryE(func() (int, error) { return 0, errBoom }).Err())
+
+		var perr *res.PanicError
+		assert.ErrorAs(t, res.TryE(func() (int, error) { panic("bad") }).Err(), &perr)
+	})
+
+	t.Run("Propagate inside Try", func(t *testing.T) {
+		r := res.Try(func() int {
+			return res.Err[int](errBoom).Propagate() + 1
+		})
+		assert.Equal(t, errBoom, r.Err())
+		assert.Equal(t, 5, res.Ok(5).Propagate())
+	})
+}
+
+func TestHandle(t *testing.T) {
+	sum := func(a, b string) (n int, err error) {
+		defer res.Handle(&err)
+		x := res.Of(strconv.Atoi(a)).Propagate()
+		y := res.Of(strconv.Atoi(b)).Propagate()
+		return x + y, nil
+	}
+
+	n, err := sum("1", "2")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	_, err = sum("1", "x")
+	var numErr *strconv.NumError
+	assert.ErrorAs(t, err, &numErr)
+
+	assert.PanicsWithValue(t, "unrelated", func() {
+		_ = func() (err error) {
+			defer res.Handle(&err)
+			panic("unrelated")
+		}()
+	})
+}
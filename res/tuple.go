@@ -0,0 +1,248 @@
+package res
+
+import (
+	"github.com/goexts/generic/maps"
+)
+
+// Triple represents an immutable tuple of three values of types A, B and C.
+// It offers the same API as Pair.
+type Triple[A, B, C any] struct {
+	first  A
+	second B
+	third  C
+}
+
+// NewTriple creates a new Triple with the given values.
+func NewTriple[A, B, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{
+		first:  first,
+		second: second,
+		third:  third,
+	}
+}
+
+// First returns the first value of the triple.
+func (t Triple[A, B, C]) First() A {
+	return t.first
+}
+
+// Second returns the second value of the triple.
+func (t Triple[A, B, C]) Second() B {
+	return t.second
+}
+
+// Third returns the third value of the triple.
+func (t Triple[A, B, C]) Third() C {
+	return t.third
+}
+
+// Values returns all values from the triple.
+func (t Triple[A, B, C]) Values() (A, B, C) {
+	return t.first, t.second, t.third
+}
+
+// Map applies the given functions to the triple's values and returns a new triple.
+func (t Triple[A, B, C]) Map(fnA func(A) A, fnB func(B) B, fnC func(C) C) Triple[A, B, C] {
+	return Triple[A, B, C]{
+		first:  fnA(t.first),
+		second: fnB(t.second),
+		third:  fnC(t.third),
+	}
+}
+
+// MapFirst applies the given function to the first value of the triple.
+// The other values remain unchanged.
+func (t Triple[A, B, C]) MapFirst(fn func(A) A) Triple[A, B, C] {
+	t.first = fn(t.first)
+	return t
+}
+
+// MapSecond applies the given function to the second value of the triple.
+// The other values remain unchanged.
+func (t Triple[A, B, C]) MapSecond(fn func(B) B) Triple[A, B, C] {
+	t.second = fn(t.second)
+	return t
+}
+
+// MapThird applies the given function to the third value of the triple.
+// The other values remain unchanged.
+func (t Triple[A, B, C]) MapThird(fn func(C) C) Triple[A, B, C] {
+	t.third = fn(t.third)
+	return t
+}
+
+// WithFirst returns a new Triple with the first value replaced by the given value.
+func (t Triple[A, B, C]) WithFirst(first A) Triple[A, B, C] {
+	t.first = first
+	return t
+}
+
+// WithSecond returns a new Triple with the second value replaced by the given value.
+func (t Triple[A, B, C]) WithSecond(second B) Triple[A, B, C] {
+	t.second = second
+	return t
+}
+
+// WithThird returns a new Triple with the third value replaced by the given value.
+func (t Triple[A, B, C]) WithThird(third C) Triple[A, B, C] {
+	t.third = third
+	return t
+}
+
+// Quad represents an immutable tuple of four values of types A, B, C and D.
+// It offers the same API as Pair.
+type Quad[A, B, C, D any] struct {
+	first  A
+	second B
+	third  C
+	fourth D
+}
+
+// NewQuad creates a new Quad with the given values.
+func NewQuad[A, B, C, D any](first A, second B, third C, fourth D) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{
+		first:  first,
+		second: second,
+		third:  third,
+		fourth: fourth,
+	}
+}
+
+// First returns the first value of the quad.
+func (q Quad[A, B, C, D]) First() A {
+	return q.first
+}
+
+// Second returns the second value of the quad.
+func (q Quad[A, B, C, D]) Second() B {
+	return q.second
+}
+
+// Third returns the third value of the quad.
+func (q Quad[A, B, C, D]) Third() C {
+	return q.third
+}
+
+// Fourth returns the fourth value of the quad.
+func (q Quad[A, B, C, D]) Fourth() D {
+	return q.fourth
+}
+
+// Values returns all values from the quad.
+func (q Quad[A, B, C, D]) Values() (A, B, C, D) {
+	return q.first, q.second, q.third, q.fourth
+}
+
+// Map applies the given functions to the quad's values and returns a new quad.
+func (q Quad[A, B, C, D]) Map(fnA func(A) A, fnB func(B) B, fnC func(C) C, fnD func(D) D) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{
+		first:  fnA(q.first),
+		second: fnB(q.second),
+		third:  fnC(q.third),
+		fourth: fnD(q.fourth),
+	}
+}
+
+// MapFirst applies the given function to the first value of the quad.
+// The other values remain unchanged.
+func (q Quad[A, B, C, D]) MapFirst(fn func(A) A) Quad[A, B, C, D] {
+	q.first = fn(q.first)
+	return q
+}
+
+// MapSecond applies the given function to the second value of the quad.
+// The other values remain unchanged.
+func (q Quad[A, B, C, D]) MapSecond(fn func(B) B) Quad[A, B, C, D] {
+	q.second = fn(q.second)
+	return q
+}
+
+// MapThird applies the given function to the third value of the quad.
+// The other values remain unchanged.
+func (q Quad[A, B, C, D]) MapThird(fn func(C) C) Quad[A, B, C, D] {
+	q.third = fn(q.third)
+	return q
+}
+
+// MapFourth applies the given function to the fourth value of the quad.
+// The other values remain unchanged.
+func (q Quad[A, B, C, D]) MapFourth(fn func(D) D) Quad[A, B, C, D] {
+	q.fourth = fn(q.fourth)
+	return q
+}
+
+// WithFirst returns a new Quad with the first value replaced by the given value.
+func (q Quad[A, B, C, D]) WithFirst(first A) Quad[A, B, C, D] {
+	q.first = first
+	return q
+}
+
+// WithSecond returns a new Quad with the second value replaced by the given value.
+func (q Quad[A, B, C, D]) WithSecond(second B) Quad[A, B, C, D] {
+	q.second = second
+	return q
+}
+
+// WithThird returns a new Quad with the third value replaced by the given value.
+func (q Quad[A, B, C, D]) WithThird(third C) Quad[A, B, C, D] {
+	q.third = third
+	return q
+}
+
+// WithFourth returns a new Quad with the fourth value replaced by the given value.
+func (q Quad[A, B, C, D]) WithFourth(fourth D) Quad[A, B, C, D] {
+	q.fourth = fourth
+	return q
+}
+
+// ZipPairs combines two slices element-wise into a slice of pairs. If the
+// slices differ in length, the result is as long as the shorter one.
+func ZipPairs[A, B any](as []A, bs []B) []Pair[A, B] {
+	n := min(len(as), len(bs))
+	pairs := make([]Pair[A, B], n)
+	for i := range n {
+		pairs[i] = NewPair(as[i], bs[i])
+	}
+	return pairs
+}
+
+// Unzip splits a slice of pairs into a slice of first values and a slice of
+// second values. It is the inverse of ZipPairs.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.Values()
+	}
+	return as, bs
+}
+
+// FromKeyValue converts a maps.KeyValue into a Pair of key and value.
+func FromKeyValue[K comparable, V any](kv maps.KeyValue[K, V]) Pair[K, V] {
+	return NewPair(kv.Key, kv.Val)
+}
+
+// ToKeyValue converts a Pair into a maps.KeyValue, using the first value as the
+// key and the second as the value.
+func ToKeyValue[K comparable, V any](p Pair[K, V]) maps.KeyValue[K, V] {
+	return maps.KV(p.first, p.second)
+}
+
+// FromKeyValues converts a slice of maps.KeyValue into a slice of pairs.
+func FromKeyValues[K comparable, V any](kvs []maps.KeyValue[K, V]) []Pair[K, V] {
+	pairs := make([]Pair[K, V], len(kvs))
+	for i, kv := range kvs {
+		pairs[i] = FromKeyValue(kv)
+	}
+	return pairs
+}
+
+// ToKeyValues converts a slice of pairs into a slice of maps.KeyValue, which can
+// for instance be turned into a map with maps.FromKVs.
+func ToKeyValues[K comparable, V any](pairs []Pair[K, V]) []maps.KeyValue[K, V] {
+	kvs := make([]maps.KeyValue[K, V], len(pairs))
+	for i, p := range pairs {
+		kvs[i] = ToKeyValue(p)
+	}
+	return kvs
+}
@@ -0,0 +1,97 @@
+package res_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goexts/generic/maps"
+	"github.com/goexts/generic/res"
+)
+
+func TestTriple(t *testing.T) {
+	tr := res.NewTriple(1, "two", 3.0)
+
+	a, b, c := tr.Values()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "two", b)
+	assert.Equal(t, 3.0, c)
+	assert.Equal(t, 1, tr.First())
+	assert.Equal(t, "two", tr.Second())
+	assert.Equal(t, 3.0, tr.Third())
+
+	double := func(n int) int { return n * 2 }
+	half := func(f float64) float64 { return f / 2 }
+	assert.Equal(t, res.NewTriple(2, "TWO", 1.5), tr.Map(double, strings.ToUpper, half))
+	assert.Equal(t, res.NewTriple(2, "two", 3.0), tr.MapFirst(double))
+	assert.Equal(t, res.NewTriple(1, "TWO", 3.0), tr.MapSecond(strings.ToUpper))
+	assert.Equal(t, res.NewTriple(1, "two", 1.5), tr.MapThird(half))
+	assert.Equal(t, res.NewTriple(9, "two", 3.0), tr.WithFirst(9))
+	assert.Equal(t, res.NewTriple(1, "x", 3.0), tr.WithSecond("x"))
+	assert.Equal(t, res.NewTriple(1, "two", 0.0), tr.WithThird(0))
+	// The original triple remains unchanged.
+	assert.Equal(t, 1, tr.First())
+
+	data, err := json.Marshal(tr)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[1,"two",3]`, string(data))
+	var decoded res.Triple[int, string, float64]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, tr, decoded)
+	assert.Error(t, json.Unmarshal([]byte(`[1,"two"]`), &decoded))
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.Equal(t, tr, decoded)
+}
+
+func TestQuad(t *testing.T) {
+	q := res.NewQuad(1, "two", 3.0, true)
+
+	a, b, c, d := q.Values()
+	assert.Equal(t, 1, a)
+	assert.Equal(t, "two", b)
+	assert.Equal(t, 3.0, c)
+	assert.True(t, d)
+	assert.True(t, q.Fourth())
+
+	not := func(b bool) bool { return !b }
+	inc := func(n int) int { return n + 1 }
+	same := func(f float64) float64 { return f }
+	assert.Equal(t, res.NewQuad(2, "TWO", 3.0, false), q.Map(inc, strings.ToUpper, same, not))
+	assert.Equal(t, res.NewQuad(2, "two", 3.0, true), q.MapFirst(inc))
+	assert.Equal(t, res.NewQuad(1, "TWO", 3.0, true), q.MapSecond(strings.ToUpper))
+	assert.Equal(t, res.NewQuad(1, "two", 3.0, true), q.MapThird(same))
+	assert.Equal(t, res.NewQuad(1, "two", 3.0, false), q.MapFourth(not))
+	assert.Equal(t, res.NewQuad(0, "", 0.0, false), q.WithFirst(0).WithSecond("").WithThird(0).WithFourth(false))
+
+	data, err := json.Marshal(q)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[1,"two",3,true]`, string(data))
+	var decoded res.Quad[int, string, float64, bool]
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, q, decoded)
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.Equal(t, q, decoded)
+}
+
+func TestZipPairs(t *testing.T) {
+	pairs := res.ZipPairs([]int{1, 2, 3}, []string{"a", "b"})
+	assert.Equal(t, []res.Pair[int, string]{res.NewPair(1, "a"), res.NewPair(2, "b")}, pairs)
+
+	as, bs := res.Unzip(pairs)
+	assert.Equal(t, []int{1, 2}, as)
+	assert.Equal(t, []string{"a", "b"}, bs)
+}
+
+func TestKeyValueInterop(t *testing.T) {
+	kv := maps.KV("a", 1)
+	p := res.FromKeyValue(kv)
+	assert.Equal(t, res.NewPair("a", 1), p)
+	assert.Equal(t, kv, res.ToKeyValue(p))
+
+	kvs := maps.KVs(maps.KV("a", 1), maps.KV("b", 2))
+	pairs := res.FromKeyValues(kvs)
+	assert.Equal(t, []res.Pair[string, int]{res.NewPair("a", 1), res.NewPair("b", 2)}, pairs)
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, maps.FromKVs(res.ToKeyValues(pairs)...))
+}